@@ -0,0 +1,202 @@
+package testcase
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/internal/fmterror"
+	"go.llib.dev/testcase/internal/shrink"
+	"go.llib.dev/testcase/sandbox"
+)
+
+// Property defines a property-based test in the current Spec context.
+// The check block is executed multiple times with pseudo-random inputs, for more, read the documentation of ForAll.
+func Property[V any](s *Spec, desc string, check func(t *T, v V), opts ...PropertyOption) {
+	s.H().Helper()
+	s.Test(desc, func(t *T) {
+		t.Helper()
+		ForAll[V](t, check, opts...)
+	})
+}
+
+// ForAll verifies that a property holds for many pseudo-randomly generated input values.
+// By default, the input values are made with T.Random's random.Factory,
+// which you can extend with random.Factory#RegisterType, or replace with the PropertyGenerator option.
+//
+// When the check block fails, the failing input is shrunk to a minimal counterexample,
+// by greedily trying simpler input values, as long as the check keeps failing with them.
+// The failure report contains the minimal counterexample
+// and the TESTCASE_SEED that reproduces the same sequence of inputs.
+//
+// The check block must be safe to run multiple times within the same test.
+func ForAll[V any](tb testing.TB, check func(t *T, v V), opts ...PropertyOption) {
+	tb.Helper()
+	var (
+		t = toT(tb)
+		c = toPropertyConfig(opts)
+		p = property[V]{T: t, Check: check}
+	)
+	for i := 0; i < c.Runs; i++ {
+		input := p.generate(c)
+		out, ok := p.holds(input)
+		if ok {
+			continue
+		}
+		p.fail(i+1, input, p.shrink(c, out))
+		return
+	}
+}
+
+type property[V any] struct {
+	T     *T
+	Check func(t *T, v V)
+}
+
+type propertyOutcome[V any] struct {
+	Input    V
+	Shrinks  int
+	Recorder *doubles.RecorderTB
+	Sandbox  sandbox.RunOutcome
+}
+
+func (p property[V]) generate(c propertyConfig) V {
+	p.T.Helper()
+	var raw any
+	if c.Generator != nil {
+		raw = c.Generator(p.T)
+	} else {
+		raw = p.T.Random.Make(*new(V))
+	}
+	if raw == nil {
+		return *new(V)
+	}
+	v, ok := raw.(V)
+	if !ok {
+		p.T.Log(fmterror.Message{
+			Name:  "Property",
+			Cause: "The generated input value doesn't have the type of the property's input.",
+			Values: []fmterror.Value{
+				{Label: "expected type", Value: fmterror.Formatted(reflect.TypeOf((*V)(nil)).Elem().String())},
+				{Label: "actual type", Value: fmterror.Formatted(reflect.TypeOf(raw).String())},
+			},
+		}.String())
+		p.T.FailNow()
+	}
+	return v
+}
+
+func (p property[V]) holds(input V) (propertyOutcome[V], bool) {
+	p.T.Helper()
+	var out = propertyOutcome[V]{
+		Input:    input,
+		Recorder: &doubles.RecorderTB{TB: p.T.TB},
+	}
+	out.Sandbox = sandbox.Run(func() {
+		p.T.Helper()
+		// since we use pointers, copy should not cause issue here.
+		// our only goal here is to avoid that the original T's .TB field changed instead of a copy T's
+		copyT := *p.T
+		nT := &copyT
+		nT.TB = out.Recorder
+		p.Check(nT, input)
+	})
+	out.Recorder.CleanupNow()
+	if !out.Sandbox.OK && !out.Sandbox.Goexit {
+		return out, false // panic is a failed check as well
+	}
+	return out, !out.Recorder.IsFailed
+}
+
+func (p property[V]) shrink(c propertyConfig, last propertyOutcome[V]) propertyOutcome[V] {
+	p.T.Helper()
+	var attempts int
+shrinking:
+	for attempts < c.MaxShrinks {
+		current := reflect.ValueOf(&last.Input).Elem()
+		for _, candidate := range shrink.Candidates(current) {
+			if c.MaxShrinks <= attempts {
+				break shrinking
+			}
+			attempts++
+			var v V
+			reflect.ValueOf(&v).Elem().Set(candidate)
+			if out, ok := p.holds(v); !ok {
+				out.Shrinks = last.Shrinks + 1
+				last = out
+				continue shrinking
+			}
+		}
+		break shrinking
+	}
+	return last
+}
+
+func (p property[V]) fail(run int, input V, minimal propertyOutcome[V]) {
+	p.T.Helper()
+	p.T.Log(fmterror.Message{
+		Name:  "Property",
+		Cause: "The property doesn't hold, the failing input has been shrunk to a minimal counterexample.",
+		Values: []fmterror.Value{
+			{Label: "counterexample", Value: minimal.Input},
+			{Label: "original input", Value: input},
+			{Label: "run", Value: run},
+			{Label: "shrinks", Value: minimal.Shrinks},
+			{Label: "seed", Value: fmterror.Formatted(fmt.Sprintf("%s=%d", environ.KeySeed, p.T.spec.seed))},
+		},
+	}.String())
+	if !minimal.Sandbox.OK && !minimal.Sandbox.Goexit {
+		p.T.Fatal("\n" + minimal.Sandbox.Trace())
+	}
+	minimal.Recorder.Forward()
+	p.T.FailNow()
+}
+
+type propertyConfig struct {
+	Runs       int
+	MaxShrinks int
+	Generator  func(t *T) any
+}
+
+type PropertyOption interface {
+	configure(*propertyConfig)
+}
+
+type propertyOptionFunc func(c *propertyConfig)
+
+func (fn propertyOptionFunc) configure(c *propertyConfig) { fn(c) }
+
+// PropertyRuns sets the number of pseudo-random input values the property is checked with.
+// By default, a property is checked with 100 input values.
+func PropertyRuns(n int) PropertyOption {
+	return propertyOptionFunc(func(c *propertyConfig) { c.Runs = n })
+}
+
+// PropertyMaxShrinks sets the upper limit of check executions during shrinking a failing input.
+// By default, shrinking stops after 1000 attempts.
+func PropertyMaxShrinks(n int) PropertyOption {
+	return propertyOptionFunc(func(c *propertyConfig) { c.MaxShrinks = n })
+}
+
+// PropertyGenerator replaces the default random.Factory based input generation with a custom input maker.
+func PropertyGenerator[V any](mk func(t *T) V) PropertyOption {
+	return propertyOptionFunc(func(c *propertyConfig) {
+		c.Generator = func(t *T) any { return mk(t) }
+	})
+}
+
+func toPropertyConfig(opts []PropertyOption) propertyConfig {
+	var c propertyConfig
+	for _, opt := range opts {
+		opt.configure(&c)
+	}
+	if c.Runs <= 0 {
+		c.Runs = 100
+	}
+	if c.MaxShrinks <= 0 {
+		c.MaxShrinks = 1000
+	}
+	return c
+}
@@ -0,0 +1,175 @@
+package testcase_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func TestForAll(t *testing.T) {
+	t.Run("when property holds, then all runs are executed", func(t *testing.T) {
+		var runs int
+		testcase.ForAll(t, func(t *testcase.T, n int) {
+			runs++
+			assert.True(t, 0 <= n)
+		})
+		assert.Equal(t, 100, runs)
+	})
+	t.Run("when runs are configured, then the given number of inputs are checked", func(t *testing.T) {
+		var runs int
+		testcase.ForAll(t, func(t *testcase.T, n int) { runs++ }, testcase.PropertyRuns(42))
+		assert.Equal(t, 42, runs)
+	})
+	t.Run("when generator is provided, then it is used to make the input", func(t *testing.T) {
+		testcase.ForAll(t, func(t *testcase.T, s string) {
+			assert.Equal(t, "foo", s)
+		}, testcase.PropertyGenerator(func(t *testcase.T) string { return "foo" }))
+	})
+	t.Run("when generator makes a value with a different type, then it fails with the expected and actual types", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var checked bool
+		out := sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, n int) {
+				checked = true
+			}, testcase.PropertyGenerator(func(t *testcase.T) string { return "foo" }))
+		})
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.False(t, checked)
+		assert.Contains(t, dtb.Logs.String(), "expected type:\tint")
+		assert.Contains(t, dtb.Logs.String(), "actual type:\tstring")
+	})
+	t.Run("when generator makes a nil value for an interface input, then it is used as the zero value", func(t *testing.T) {
+		var runs int
+		testcase.ForAll(t, func(t *testcase.T, err error) {
+			runs++
+			assert.Nil(t, err)
+		}, testcase.PropertyRuns(3), testcase.PropertyGenerator(func(t *testcase.T) error { return nil }))
+		assert.Equal(t, 3, runs)
+	})
+	t.Run("when property fails, then the input is shrunk to a minimal counterexample", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var last int
+		out := sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, n int) {
+				if 10 <= n {
+					last = n
+					t.Fail()
+				}
+			})
+		})
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.Equal(t, 10, last)
+		assert.Contains(t, dtb.Logs.String(), "counterexample:\t10")
+		assert.Contains(t, dtb.Logs.String(), environ.KeySeed+"=")
+	})
+	t.Run("when property fails with a slice, then both the length and the elements are shrunk", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var last []string
+		sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, vs []string) {
+				if 2 <= len(vs) {
+					last = vs
+					t.Fail()
+				}
+			}, testcase.PropertyGenerator(func(t *testcase.T) []string {
+				return []string{"foo", "bar", "baz"}
+			}))
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.Equal(t, []string{"", ""}, last)
+	})
+	t.Run("when property fails with a struct, then the irrelevant fields are shrunk to zero", func(t *testing.T) {
+		type User struct {
+			Name string
+			Age  int
+		}
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var last User
+		sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, u User) {
+				if 18 <= u.Age {
+					last = u
+					t.Fail()
+				}
+			}, testcase.PropertyGenerator(func(t *testcase.T) User {
+				return User{Name: t.Random.StringNC(8, "abc"), Age: t.Random.IntB(18, 99)}
+			}))
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.Equal(t, User{Age: 18}, last)
+	})
+	t.Run("when check panics, then it is reported as a failure", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, n int) {
+				if 10 <= n {
+					panic(fmt.Sprintf("boom: %d", n))
+				}
+			})
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "boom: 10")
+	})
+	t.Run("when max shrinks is reached, then shrinking stops", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var runs int
+		sandbox.Run(func() {
+			testcase.ForAll(dtb, func(t *testcase.T, s string) {
+				runs++
+				assert.True(t, len(s) < 20)
+			}, testcase.PropertyMaxShrinks(3), testcase.PropertyGenerator(func(t *testcase.T) string {
+				return strings.Repeat("x", 42)
+			}))
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.Equal(t, 1+3, runs)
+	})
+	t.Run("when the same seed is used, then the same counterexample is found", func(t *testing.T) {
+		seed := fmt.Sprintf("%d", testcase.NewT(t).Random.Int())
+		find := func() string {
+			var last string
+			dtb := &doubles.TB{StubName: t.Name()}
+			defer dtb.Finish()
+			sandbox.Run(func() {
+				testcase.ForAll(dtb, func(t *testcase.T, s string) {
+					if strings.ContainsAny(s, "aeiou") {
+						last = s
+						t.Fail()
+					}
+				}, testcase.PropertyGenerator(func(t *testcase.T) string {
+					return t.Random.StringNC(t.Random.IntB(5, 50), "abcdefghijklmnopqrstuvwxyz")
+				}))
+			})
+			return last
+		}
+		testcase.SetEnv(t, environ.KeySeed, seed)
+		assert.Equal(t, find(), find())
+	})
+}
+
+func TestProperty(t *testing.T) {
+	s := testcase.NewSpec(t)
+
+	var checked int
+	testcase.Property(s, "addition is commutative", func(t *testcase.T, v [2]int8) {
+		checked++
+		assert.Equal(t, int(v[0])+int(v[1]), int(v[1])+int(v[0]))
+	}, testcase.PropertyRuns(7))
+
+	s.Finish()
+	assert.Equal(t, 7, checked)
+}
@@ -0,0 +1,241 @@
+package testcase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.llib.dev/testcase/internal/doc"
+	"go.llib.dev/testcase/internal/environ"
+)
+
+// Reporter writes a machine-readable report about the context tree of a Spec.
+// The report is written once the top-level Spec finished with all of its tests.
+//
+// Each ReportCase carries the test's context path, result, tags, seed, duration, retry count, failure message and logs,
+// so CI tools can render the specs natively, without scraping the output of `go test -v`.
+type Reporter = doc.Reporter
+
+// ReportCase is the entry of a test in the report.
+type ReportCase = doc.TestingCase
+
+// JSONReporter writes the context tree of a Spec as JSON.
+type JSONReporter = doc.JSONReporter
+
+// JUnitReporter writes the tests of a Spec in the JUnit XML format.
+type JUnitReporter = doc.JUnitReporter
+
+// WithReporter registers a Reporter that writes the report of the Spec into the given io.Writer.
+// The option only takes effect on a top-level Spec.
+//
+// Reporters can be also selected through the TESTCASE_REPORT environment variable,
+// which makes each top-level Spec write its report into a file:
+//
+//	TESTCASE_REPORT=junit:/tmp/reports go test ./...
+func WithReporter(r Reporter, w io.Writer) SpecOption {
+	return specOptionFunc(func(s *Spec) {
+		s.doc.reports = append(s.doc.reports, specReport{Reporter: r, Writer: w})
+	})
+}
+
+type specReport struct {
+	Reporter Reporter
+	// Writer is the destination of the report.
+	Writer io.Writer
+	// Path is the destination file of the report, when Writer is not provided.
+	Path string
+}
+
+func (r specReport) write(ctx context.Context, tcs []ReportCase) (rErr error) {
+	if r.Writer != nil {
+		return r.Reporter.Report(ctx, r.Writer, tcs)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(r.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && rErr == nil {
+			rErr = err
+		}
+	}()
+	return r.Reporter.Report(ctx, f, tcs)
+}
+
+var reportFormats = map[string]struct {
+	Reporter  Reporter
+	Extension string
+}{
+	"json":  {Reporter: JSONReporter{Indent: "  "}, Extension: ".json"},
+	"junit": {Reporter: JUnitReporter{}, Extension: ".xml"},
+}
+
+func lookupReportFromEnv(tb testing.TB) (specReport, bool, error) {
+	raw, ok := os.LookupEnv(environ.KeyReport)
+	if !ok || raw == "" {
+		return specReport{}, false, nil
+	}
+	format, dir := raw, "."
+	if i := strings.Index(raw, ":"); 0 <= i {
+		format, dir = raw[:i], raw[i+1:]
+	}
+	rf, ok := reportFormats[strings.ToLower(strings.TrimSpace(format))]
+	if !ok {
+		var formats []string
+		for name := range reportFormats {
+			formats = append(formats, name)
+		}
+		sort.Strings(formats)
+		return specReport{}, false, fmt.Errorf("%s has an unknown report format: %q (accepted formats: %s)",
+			environ.KeyReport, format, strings.Join(formats, ", "))
+	}
+	return specReport{
+		Reporter: rf.Reporter,
+		Path:     filepath.Join(dir, reportFileName(tb.Name())+rf.Extension),
+	}, true, nil
+}
+
+var reportFileNameUnsafeChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// reportFileName prefixes the test name with the working directory's name,
+// to avoid collision between tests with the same name in different packages.
+func reportFileName(testName string) string {
+	name := reportFileNameUnsafeChars.ReplaceAllString(testName, "_")
+	if wd, err := os.Getwd(); err == nil {
+		name = reportFileNameUnsafeChars.ReplaceAllString(filepath.Base(wd), "_") + "." + name
+	}
+	return name
+}
+
+func (spec *Spec) isReporting() bool {
+	return 0 < len(spec.specsFromParent()[0].doc.reports)
+}
+
+func (spec *Spec) report(tcs []ReportCase) {
+	helper(spec.testingTB).Helper()
+	// The top-level spec has no description, so the test's name is used in its place.
+	var cases = make([]ReportCase, 0, len(tcs))
+	for _, tc := range tcs {
+		if 0 < len(tc.ContextPath) && tc.ContextPath[0] == "" {
+			path := append([]string{spec.testingTB.Name()}, tc.ContextPath[1:]...)
+			tc.ContextPath = path
+		}
+		cases = append(cases, tc)
+	}
+	for _, r := range spec.doc.reports {
+		if err := r.write(context.Background(), cases); err != nil {
+			spec.testingTB.Errorf("reporter encountered an error: %s", err.Error())
+		}
+	}
+}
+
+func (spec *Spec) getTags() []string {
+	var tags []string
+	for tag := range spec.getTagSet() {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// reportTB captures the output of a test for the report.
+// Error, Fatal and Skip messages are kept as the failure message,
+// while everything the test logs is kept separately as its logs.
+type reportTB struct {
+	testing.TB
+
+	mutex    sync.Mutex
+	messages []string
+	logs     []string
+}
+
+func (tb *reportTB) log(msg string) {
+	msg = strings.TrimSpace(strings.ReplaceAll(msg, "\r", ""))
+	if msg == "" {
+		return
+	}
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	tb.logs = append(tb.logs, msg)
+}
+
+func (tb *reportTB) capture(msg string) {
+	msg = strings.TrimSpace(strings.ReplaceAll(msg, "\r", ""))
+	if msg == "" {
+		return
+	}
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	tb.messages = append(tb.messages, msg)
+	tb.logs = append(tb.logs, msg)
+}
+
+func (tb *reportTB) message() string {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	return strings.Join(tb.messages, "\n")
+}
+
+func (tb *reportTB) output() string {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	return strings.Join(tb.logs, "\n")
+}
+
+func (tb *reportTB) Log(args ...any) {
+	tb.TB.Helper()
+	tb.log(fmt.Sprintln(args...))
+	tb.TB.Log(args...)
+}
+
+func (tb *reportTB) Logf(format string, args ...any) {
+	tb.TB.Helper()
+	tb.log(fmt.Sprintf(format, args...))
+	tb.TB.Logf(format, args...)
+}
+
+func (tb *reportTB) Error(args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintln(args...))
+	tb.TB.Error(args...)
+}
+
+func (tb *reportTB) Errorf(format string, args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintf(format, args...))
+	tb.TB.Errorf(format, args...)
+}
+
+func (tb *reportTB) Fatal(args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintln(args...))
+	tb.TB.Fatal(args...)
+}
+
+func (tb *reportTB) Fatalf(format string, args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintf(format, args...))
+	tb.TB.Fatalf(format, args...)
+}
+
+func (tb *reportTB) Skip(args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintln(args...))
+	tb.TB.Skip(args...)
+}
+
+func (tb *reportTB) Skipf(format string, args ...any) {
+	tb.TB.Helper()
+	tb.capture(fmt.Sprintf(format, args...))
+	tb.TB.Skipf(format, args...)
+}
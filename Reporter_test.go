@@ -0,0 +1,130 @@
+package testcase_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func TestWithReporter(t *testing.T) {
+	type Node struct {
+		Name    string   `json:"name"`
+		Result  string   `json:"result"`
+		Tags    []string `json:"tags"`
+		Seed    *int64   `json:"seed"`
+		Retries int      `json:"retries"`
+		Failure string   `json:"failure"`
+		Logs    string   `json:"logs"`
+		Nodes   []Node   `json:"nodes"`
+	}
+
+	testcase.SetEnv(t, environ.KeySeed, "42")
+	var buf bytes.Buffer
+	stub := &doubles.TB{StubName: "TestXYZ"}
+	s := testcase.NewSpec(stub, testcase.WithReporter(testcase.JSONReporter{}, &buf))
+	s.Tag("smoke")
+	s.Context("ctx", func(s *testcase.Spec) {
+		s.Test("pass", func(t *testcase.T) {})
+		s.Test("fail", func(t *testcase.T) { t.Log("debug"); t.Fatal("boom") })
+		s.Test("skip", func(t *testcase.T) { t.Log("debug"); t.Skip("not today") })
+		s.Test("assert", func(t *testcase.T) { t.Log("debug"); assert.Equal(t, 1, 2) })
+	})
+	sandbox.Run(func() {
+		s.Finish()
+		stub.Finish()
+	})
+
+	var nodes []Node
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &nodes))
+	assert.Equal(t, 1, len(nodes))
+	assert.Equal(t, "TestXYZ", nodes[0].Name)
+	assert.Equal(t, 1, len(nodes[0].Nodes))
+	ctx := nodes[0].Nodes[0]
+	assert.Equal(t, "ctx", ctx.Name)
+	assert.Empty(t, ctx.Result)
+	assert.Equal(t, 4, len(ctx.Nodes))
+	var names []string
+	for _, n := range ctx.Nodes {
+		names = append(names, n.Name)
+	}
+	assert.Equal(t, []string{"pass", "fail", "skip", "assert"}, names)
+
+	tests := map[string]Node{}
+	for _, n := range ctx.Nodes {
+		tests[n.Name] = n
+		assert.Equal(t, []string{"smoke"}, n.Tags)
+		assert.NotNil(t, n.Seed)
+		assert.Equal(t, int64(42), *n.Seed)
+	}
+	assert.Equal(t, "pass", tests["pass"].Result)
+	assert.Empty(t, tests["pass"].Failure)
+	assert.Equal(t, "fail", tests["fail"].Result)
+	assert.Equal(t, "boom", tests["fail"].Failure)
+	assert.Equal(t, "debug\nboom", tests["fail"].Logs)
+	assert.Equal(t, "skip", tests["skip"].Result)
+	assert.Equal(t, "not today", tests["skip"].Failure)
+	assert.Equal(t, "debug\nnot today", tests["skip"].Logs)
+	assert.Equal(t, "fail", tests["assert"].Result)
+	assert.Empty(t, tests["assert"].Failure)
+	assert.Contains(t, tests["assert"].Logs, "debug\n[Equal]")
+}
+
+func TestWithReporter_flakyRetries(t *testing.T) {
+	var buf bytes.Buffer
+	stub := &doubles.TB{StubName: "TestXYZ"}
+	s := testcase.NewSpec(stub, testcase.WithReporter(testcase.JUnitReporter{}, &buf))
+	var n int
+	s.Test("flaky", func(t *testcase.T) {
+		n++
+		assert.True(t, 3 <= n)
+	}, testcase.Flaky(5))
+	sandbox.Run(func() {
+		s.Finish()
+		stub.Finish()
+	})
+	assert.False(t, stub.IsFailed)
+	assert.Contains(t, buf.String(), `<testsuite name="TestXYZ" tests="1" failures="0" skipped="0"`)
+	assert.Contains(t, buf.String(), `<testcase name="flaky" classname="TestXYZ"`)
+	assert.Contains(t, buf.String(), `<property name="retries" value="2"></property>`)
+}
+
+func TestReporter_envReport(t *testing.T) {
+	dir := t.TempDir()
+	testcase.SetEnv(t, environ.KeyReport, "junit:"+dir)
+	stub := &doubles.TB{StubName: "TestXYZ/sub"}
+	s := testcase.NewSpec(stub)
+	s.Test("foo", func(t *testcase.T) {})
+	sandbox.Run(func() {
+		s.Finish()
+		stub.Finish()
+	})
+
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	path := filepath.Join(dir, filepath.Base(wd)+".TestXYZ_sub.xml")
+	bs, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(bs), "<?xml"))
+	assert.Contains(t, string(bs), `<testcase name="foo" classname="TestXYZ/sub"`)
+}
+
+func TestReporter_envReportWithUnknownFormat(t *testing.T) {
+	testcase.SetEnv(t, environ.KeyReport, "yaml")
+	stub := &doubles.TB{}
+	out := sandbox.Run(func() {
+		testcase.NewSpec(stub)
+	})
+	assert.False(t, out.OK)
+	assert.True(t, stub.IsFailed)
+	assert.Contains(t, stub.Logs.String(), environ.KeyReport)
+	assert.Contains(t, stub.Logs.String(), "junit")
+}
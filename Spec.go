@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"go.llib.dev/testcase/assert"
 	"go.llib.dev/testcase/internal"
@@ -28,6 +29,21 @@ func NewSpec(tb testing.TB, opts ...SpecOption) *Spec {
 		s.seed = seedForSpec(tb)
 		s.orderer = newOrderer(s.seed)
 		s.sync = true
+		if isValidTestingTB(tb) {
+			if _, err := getCachedShardSettings(); err != nil {
+				tb.Fatal(err.Error())
+			}
+			if _, err := getCachedTagSettings(); err != nil {
+				tb.Fatal(err.Error())
+			}
+			report, ok, err := lookupReportFromEnv(tb)
+			if err != nil {
+				tb.Fatal(err.Error())
+			}
+			if ok {
+				s.doc.reports = append(s.doc.reports, report)
+			}
+		}
 	}
 	applyGlobal(s)
 	if isValidTestingTB(tb) {
@@ -98,6 +114,7 @@ type Spec struct {
 	doc struct {
 		once    sync.Once
 		maker   doc.Formatter
+		reports []specReport
 		results []doc.TestingCase
 	}
 
@@ -119,6 +136,9 @@ type Spec struct {
 	skipTest      bool
 	skipBenchmark bool
 
+	detectMutations      bool
+	detectGoroutineLeaks bool
+
 	finished bool
 	orderer  orderer
 	seed     int64
@@ -298,11 +318,15 @@ func (spec *Spec) Sequential() {
 //
 // They can be combined as well.
 //
+// For more complex selections, TESTCASE_TAGS accepts a tag expression
+// with the "!", "&&", "||" operators and grouping with parentheses.
+//
 // example usage:
 //
 //	TESTCASE_TAG_INCLUDE='E2E' go test ./...
 //	TESTCASE_TAG_EXCLUDE='E2E' go test ./...
 //	TESTCASE_TAG_INCLUDE='E2E' TESTCASE_TAG_EXCLUDE='list,of,excluded,tags' go test ./...
+//	TESTCASE_TAGS='(db && !slow) || smoke' go test ./...
 func (spec *Spec) Tag(tags ...string) {
 	helper(spec.testingTB).Helper()
 	spec.modify(func(spec *Spec) {
@@ -318,27 +342,11 @@ func (spec *Spec) isAllowedToRun() bool {
 		return false
 	}
 
-	currentTagSet := spec.getTagSet()
-	settings := getCachedTagSettings()
-
-	for tag := range currentTagSet {
-		if _, ok := settings.Exclude[tag]; ok {
-			return false
-		}
-	}
-
-	if len(settings.Include) == 0 {
-		return true
+	if spec.isTest && !spec.isShardAllowedToRun() {
+		return false
 	}
 
-	var allowed bool
-	for tag := range currentTagSet {
-		if _, ok := settings.Include[tag]; ok {
-			allowed = true
-		}
-	}
-	// TODO: Exclude
-	return allowed
+	return spec.isTagAllowedToRun()
 }
 
 func (spec *Spec) isTestAllowedToRun() bool {
@@ -505,21 +513,53 @@ func (spec *Spec) runTB(tb testing.TB, blk func(*T)) {
 		tb.Parallel()
 	}
 
+	var (
+		start = time.Now()
+		runs  int
+		rtb   *reportTB
+	)
+	if spec.isReporting() {
+		rtb = &reportTB{TB: tb}
+		tb = rtb
+	}
+
+	defer func() {
+		if tb.Failed() && isValidTestingTB(tb) {
+			// Help developers to reproduce the failed test execution.
+			internal.Log(tb, "reproduce with:", spec.reproductionCommand(tb.Name()))
+		}
+	}()
+
 	defer func() {
 		var contextPath []string
 		for _, spec := range spec.specsFromParent() {
 			contextPath = append(contextPath, spec.description)
 		}
-		spec.doc.results = append(spec.doc.results, doc.TestingCase{
+		tc := doc.TestingCase{
 			ContextPath: contextPath,
 			TestFailed:  tb.Failed(),
 			TestSkipped: tb.Skipped(),
-		})
+		}
+		if rtb != nil {
+			tc.Tags = spec.getTags()
+			tc.Seed = spec.seed
+			tc.Duration = time.Since(start)
+			if 1 < runs {
+				tc.Retries = runs - 1
+			}
+			if tc.TestFailed || tc.TestSkipped {
+				tc.FailureMessage = rtb.message()
+				tc.Logs = rtb.output()
+			}
+		}
+		spec.doc.results = append(spec.doc.results, tc)
 	}()
 
 	test := func(tb testing.TB) {
 		tb.Helper()
+		runs++
 		t := newT(tb, spec)
+		defer spec.guardGoroutineLeaks(tb)()
 		defer t.setUp()()
 		blk(t)
 	}
@@ -662,6 +702,10 @@ func (spec *Spec) documentResults() {
 		if 0 < len(doc) {
 			internal.Log(spec.testingTB, doc)
 		}
+
+		if 0 < len(spec.doc.reports) {
+			spec.report(collect(spec))
+		}
 	})
 }
 
@@ -9,6 +9,7 @@ import (
 	"go.llib.dev/testcase/pp"
 
 	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/tagexpr"
 	"go.llib.dev/testcase/internal/teardown"
 	"go.llib.dev/testcase/random"
 )
@@ -157,6 +158,20 @@ func (t *T) HasTag(tag string) bool {
 	return ok
 }
 
+// MatchTags tells if the tags of the current test satisfy the given tag expression.
+// The expression uses the same syntax as TESTCASE_TAGS,
+// so conditional setups can be expressed the same way as the test selection.
+//
+//	if t.MatchTags("db && !slow") { /* ... */ }
+func (t *T) MatchTags(expr string) bool {
+	t.TB.Helper()
+	e, err := tagexpr.Parse(expr)
+	if err != nil {
+		t.TB.Fatal(err.Error())
+	}
+	return e.Match(t.tags)
+}
+
 func (t *T) contexts() []*Spec {
 	if t.cache.contexts == nil {
 		t.cache.contexts = t.spec.specsFromParent()
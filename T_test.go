@@ -3,8 +3,10 @@ package testcase_test
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -343,6 +345,41 @@ func TestT_HasTag(t *testing.T) {
 	})
 }
 
+func TestT_MatchTags(t *testing.T) {
+	s := testcase.NewSpec(t)
+
+	s.Context(`db`, func(s *testcase.Spec) {
+		s.Tag(`db`)
+
+		s.Context(`slow`, func(s *testcase.Spec) {
+			s.Tag(`slow`)
+
+			s.Test(``, func(t *testcase.T) {
+				assert.True(t, t.MatchTags(`db && slow`))
+				assert.True(t, t.MatchTags(`(db && !slow) || smoke || slow`))
+				assert.False(t, t.MatchTags(`db && !slow`))
+			})
+		})
+
+		s.Test(`fast`, func(t *testcase.T) {
+			assert.True(t, t.MatchTags(`db && !slow`))
+			assert.True(t, t.MatchTags(`(db && !slow) || smoke`))
+			assert.False(t, t.MatchTags(`!db || slow`))
+		})
+	})
+
+	s.Test(`when the expression is invalid, then the test fails`, func(t *testcase.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() {
+			testcase.NewTWithSpec(dtb, nil).MatchTags(`db &&`)
+		})
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), `invalid tag expression`)
+	})
+}
+
 func TestT_Random(t *testing.T) {
 	randomGenerationWorks := func(t *testcase.T) {
 		assert.Retry{Strategy: assert.Waiter{WaitDuration: time.Second}}.Assert(t, func(it testing.TB) {
@@ -466,6 +503,42 @@ func TestT_Eventually(t *testing.T) {
 		assert.True(t, ran)
 	})
 
+	t.Run("when the eventually block makes snapshots, then every attempt starts from the first snapshot", func(t *testing.T) {
+		wd, err := os.Getwd()
+		assert.NoError(t, err)
+		dir := t.TempDir()
+		assert.NoError(t, os.Chdir(dir))
+		t.Cleanup(func() { _ = os.Chdir(wd) })
+		t.Setenv(environ.KeyUpdateSnapshots, "true")
+
+		stub := &doubles.TB{StubName: "TestX"}
+		s := testcase.NewSpec(stub)
+		s.HasSideEffect()
+
+		s.Test(``, func(tcT *testcase.T) {
+			var attempts int
+			tcT.Eventually(func(t *testcase.T) {
+				attempts++
+				assert.MatchSnapshot(t, "first")
+				assert.MatchSnapshot(t, "second")
+				assert.True(t, 2 <= attempts)
+			})
+		})
+
+		stub.Finish()
+		s.Finish()
+
+		assert.Must(t).True(!stub.IsFailed, `expected to pass`)
+		var snapshots []string
+		assert.NoError(t, filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				snapshots = append(snapshots, filepath.Base(path))
+			}
+			return err
+		}))
+		assert.Equal(t, 2, len(snapshots), assert.Message(fmt.Sprint(snapshots)))
+	})
+
 	t.Run("when failure occurs during the variable initialisation", func(t *testing.T) {
 		t.Run("permanently", func(t *testing.T) {
 			stub := &doubles.TB{}
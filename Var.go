@@ -179,9 +179,15 @@ func (v Var[V]) bind(s *Spec) {
 //
 // For example, you may persist the value in a storage as part of the initialization block,
 // and then when the testCase/then block is reached, the entity is already present in the resource.
+//
+// When DetectMutations is enabled, the test fails if it mutates the eager loaded value.
+// Even though the value is made for each test, it is loaded before the test's hooks,
+// and what it represents, like the persisted entity, is only made once at that point.
+// Mutating it in the test makes the value diverge from its loaded state that the rest of the test relies on.
+// When a test needs to change the value, use a lazily loaded variable instead.
 func (v Var[V]) EagerLoading(s *Spec) Var[V] {
 	helper(s.testingTB).Helper()
-	s.Before(func(t *T) { _ = v.Get(t) })
+	s.Before(func(t *T) { t.guardMutation(v.ID, v.Get(t)) })
 	return v
 }
 
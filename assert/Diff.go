@@ -6,4 +6,9 @@ type diffFn func(value, othValue any) string
 
 // DiffFunc is the function that will be used to print out two object if they are not equal.
 // You can use your preferred diff implementation if you are not happy with the pretty print diff format.
+//
+// By default, it is a line based side-by-side diff of the pp.Format outputs.
+// For deeply nested values, pp.DiffStructural reports only the changed paths:
+//
+//	assert.DiffFunc = pp.DiffStructural[any]
 var DiffFunc diffFn = pp.DiffFormat[any]
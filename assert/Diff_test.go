@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/pp"
 )
 
 func TestDiffFunc(t *testing.T) {
@@ -15,3 +17,25 @@ func TestDiffFunc(t *testing.T) {
 		t.Fatalf("diff function is not deterministic")
 	}
 }
+
+func TestDiffFunc_usesThePrettyPrintSettings(t *testing.T) {
+	type Config struct {
+		Name   string
+		Secret string `pp:"redact"`
+		Items  []int
+	}
+	og := pp.DefaultFormatter
+	defer func() { pp.DefaultFormatter = og }()
+	pp.DefaultFormatter = pp.Formatter{MaxLength: 3}
+
+	dtb := &doubles.TB{}
+	defer dtb.Finish()
+	assert.Should(dtb).Equal(
+		Config{Name: "foo", Secret: "s3cr3t", Items: []int{1, 2, 3, 4, 5}},
+		Config{Name: "bar", Secret: "s3cr3t", Items: []int{1, 2, 3, 4, 5}})
+	assert.True(t, dtb.IsFailed)
+	logs := dtb.Logs.String()
+	assert.NotContains(t, logs, "s3cr3t")
+	assert.Contains(t, logs, "/* redacted */")
+	assert.Contains(t, logs, "/* 2 more */")
+}
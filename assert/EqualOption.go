@@ -0,0 +1,117 @@
+package assert
+
+import (
+	"time"
+
+	"go.llib.dev/testcase/internal/fmterror"
+	"go.llib.dev/testcase/internal/reflects"
+)
+
+// EqualOption alters the comparison of Asserter.EqualWith for a single assertion,
+// without affecting the equality checks of other tests like RegisterEqual would.
+//
+// A Message is also an EqualOption, so it can be passed along with the other options.
+type EqualOption interface {
+	configureEqual(*equalConfig)
+}
+
+type equalConfig struct {
+	Options  reflects.EqualOptions
+	Messages []Message
+}
+
+type equalOptionFunc func(*equalConfig)
+
+func (fn equalOptionFunc) configureEqual(c *equalConfig) { fn(c) }
+
+func (m Message) configureEqual(c *equalConfig) { c.Messages = append(c.Messages, m) }
+
+// IgnoreFields will skip the comparison of the struct fields with the given names, at any depth.
+func IgnoreFields(names ...string) EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		if c.Options.IgnoreFields == nil {
+			c.Options.IgnoreFields = make(map[string]struct{})
+		}
+		for _, name := range names {
+			c.Options.IgnoreFields[name] = struct{}{}
+		}
+	})
+}
+
+// IgnoreUnexported will skip the comparison of the unexported struct fields.
+func IgnoreUnexported() EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		c.Options.IgnoreUnexported = true
+	})
+}
+
+// UnorderedSlices will compare slices regardless of the order of their elements.
+func UnorderedSlices() EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		c.Options.UnorderedSlices = true
+	})
+}
+
+// TimeApprox will consider two time.Time values equal, when their difference is within the given tolerance.
+func TimeApprox(tolerance time.Duration) EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		c.Options.TimeApprox = tolerance
+	})
+}
+
+// FloatEpsilon will consider two floating point values equal, when their difference is within the given epsilon.
+func FloatEpsilon(epsilon float64) EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		c.Options.FloatEpsilon = epsilon
+	})
+}
+
+// TreatNilAsEmpty will consider nil slices and maps equal to their empty counterparts.
+func TreatNilAsEmpty() EqualOption {
+	return equalOptionFunc(func(c *equalConfig) {
+		c.Options.TreatNilAsEmpty = true
+	})
+}
+
+func toEqualConfig(opts []EqualOption) equalConfig {
+	var c equalConfig
+	for _, opt := range opts {
+		opt.configureEqual(&c)
+	}
+	return c
+}
+
+// EqualWith works like Equal, but the comparison can be altered with EqualOption(s).
+//
+//	assert.Must(tb).EqualWith(expected, actual, assert.IgnoreFields("UpdatedAt"), assert.UnorderedSlices())
+func (a Asserter) EqualWith(v, oth any, opts ...EqualOption) {
+	a.TB.Helper()
+	const method = "EqualWith"
+	c := toEqualConfig(opts)
+
+	if a.checkTypeEquality(method, v, oth, c.Messages) {
+		return
+	}
+
+	isEq, err := reflects.DeepEqualWith(v, oth, c.Options)
+	if err != nil {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "Equality check failed.",
+			Message: toMsg(c.Messages),
+			Values:  []fmterror.Value{{Label: "error", Value: fmterror.Formatted(err.Error())}},
+		})
+		return
+	}
+	if isEq {
+		pass(a.TB)
+		return
+	}
+
+	a.TB.Log(fmterror.Message{
+		Name:    method,
+		Message: toMsg(c.Messages),
+	}.String())
+	a.TB.Logf("\n\n%s", DiffFunc(v, oth))
+	a.fail()
+}
@@ -0,0 +1,178 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+type equalWithEntity struct {
+	ID        string
+	Tags      []string
+	Score     float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Children  []equalWithEntity
+	Attrs     map[string]string
+	version   int
+}
+
+func TestAsserter_EqualWith(t *testing.T) {
+	now := time.Now()
+	tenth := 0.1 // non-constant, so the floating point arithmetic is not exact
+
+	type TestCase struct {
+		Desc   string
+		V, Oth any
+		Opts   []assert.EqualOption
+		Equal  bool
+	}
+	for _, tc := range []TestCase{
+		{
+			Desc:  "without options it works like Equal",
+			V:     equalWithEntity{ID: "1", UpdatedAt: now},
+			Oth:   equalWithEntity{ID: "1", UpdatedAt: now.Add(time.Hour)},
+			Equal: false,
+		},
+		{
+			Desc:  "IgnoreFields - ignored field differs",
+			V:     equalWithEntity{ID: "1", UpdatedAt: now},
+			Oth:   equalWithEntity{ID: "1", UpdatedAt: now.Add(time.Hour)},
+			Opts:  []assert.EqualOption{assert.IgnoreFields("UpdatedAt")},
+			Equal: true,
+		},
+		{
+			Desc:  "IgnoreFields - ignored field differs in a nested value",
+			V:     equalWithEntity{ID: "1", Children: []equalWithEntity{{ID: "2", UpdatedAt: now}}},
+			Oth:   equalWithEntity{ID: "1", Children: []equalWithEntity{{ID: "2"}}},
+			Opts:  []assert.EqualOption{assert.IgnoreFields("UpdatedAt", "CreatedAt")},
+			Equal: true,
+		},
+		{
+			Desc:  "IgnoreFields - other field differs",
+			V:     equalWithEntity{ID: "1"},
+			Oth:   equalWithEntity{ID: "2"},
+			Opts:  []assert.EqualOption{assert.IgnoreFields("UpdatedAt")},
+			Equal: false,
+		},
+		{
+			Desc:  "IgnoreUnexported - unexported field differs",
+			V:     equalWithEntity{ID: "1", version: 1},
+			Oth:   equalWithEntity{ID: "1", version: 2},
+			Opts:  []assert.EqualOption{assert.IgnoreUnexported()},
+			Equal: true,
+		},
+		{
+			Desc:  "IgnoreUnexported - without the option",
+			V:     equalWithEntity{ID: "1", version: 1},
+			Oth:   equalWithEntity{ID: "1", version: 2},
+			Equal: false,
+		},
+		{
+			Desc:  "UnorderedSlices - same elements in a different order",
+			V:     equalWithEntity{Tags: []string{"a", "b", "b", "c"}},
+			Oth:   equalWithEntity{Tags: []string{"c", "b", "a", "b"}},
+			Opts:  []assert.EqualOption{assert.UnorderedSlices()},
+			Equal: true,
+		},
+		{
+			Desc:  "UnorderedSlices - different element counts",
+			V:     []string{"a", "a", "b"},
+			Oth:   []string{"a", "b", "b"},
+			Opts:  []assert.EqualOption{assert.UnorderedSlices()},
+			Equal: false,
+		},
+		{
+			Desc:  "UnorderedSlices - nested structures",
+			V:     []equalWithEntity{{ID: "1", Tags: []string{"x", "y"}}, {ID: "2"}},
+			Oth:   []equalWithEntity{{ID: "2"}, {ID: "1", Tags: []string{"y", "x"}}},
+			Opts:  []assert.EqualOption{assert.UnorderedSlices()},
+			Equal: true,
+		},
+		{
+			Desc:  "TimeApprox - within tolerance",
+			V:     equalWithEntity{CreatedAt: now},
+			Oth:   equalWithEntity{CreatedAt: now.Add(-500 * time.Millisecond)},
+			Opts:  []assert.EqualOption{assert.TimeApprox(time.Second)},
+			Equal: true,
+		},
+		{
+			Desc:  "TimeApprox - outside of tolerance",
+			V:     equalWithEntity{CreatedAt: now},
+			Oth:   equalWithEntity{CreatedAt: now.Add(2 * time.Second)},
+			Opts:  []assert.EqualOption{assert.TimeApprox(time.Second)},
+			Equal: false,
+		},
+		{
+			Desc:  "FloatEpsilon - within epsilon",
+			V:     equalWithEntity{Score: tenth + 0.2},
+			Oth:   equalWithEntity{Score: 0.3},
+			Opts:  []assert.EqualOption{assert.FloatEpsilon(1e-9)},
+			Equal: true,
+		},
+		{
+			Desc:  "FloatEpsilon - without the option",
+			V:     tenth + 0.2,
+			Oth:   0.3,
+			Equal: false,
+		},
+		{
+			Desc:  "FloatEpsilon - outside of epsilon",
+			V:     1.0,
+			Oth:   1.1,
+			Opts:  []assert.EqualOption{assert.FloatEpsilon(1e-9)},
+			Equal: false,
+		},
+		{
+			Desc:  "TreatNilAsEmpty - nil and empty slice and map",
+			V:     equalWithEntity{Tags: nil, Attrs: nil},
+			Oth:   equalWithEntity{Tags: []string{}, Attrs: map[string]string{}},
+			Opts:  []assert.EqualOption{assert.TreatNilAsEmpty()},
+			Equal: true,
+		},
+		{
+			Desc:  "TreatNilAsEmpty - without the option",
+			V:     equalWithEntity{Tags: nil},
+			Oth:   equalWithEntity{Tags: []string{}},
+			Equal: false,
+		},
+		{
+			Desc:  "options can be combined",
+			V:     equalWithEntity{ID: "1", Tags: []string{"a", "b"}, UpdatedAt: now, Score: tenth + 0.2},
+			Oth:   equalWithEntity{ID: "1", Tags: []string{"b", "a"}, Score: 0.3},
+			Opts:  []assert.EqualOption{assert.IgnoreFields("UpdatedAt"), assert.UnorderedSlices(), assert.FloatEpsilon(1e-9)},
+			Equal: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			dtb := &doubles.TB{}
+			defer dtb.Finish()
+			assert.Should(dtb).EqualWith(tc.V, tc.Oth, tc.Opts...)
+			assert.Equal(t, !tc.Equal, dtb.IsFailed, assert.Message(dtb.Logs.String()))
+		})
+	}
+}
+
+func TestEqualWith(t *testing.T) {
+	t.Run("messages are passed as options", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		sandbox.Run(func() { assert.EqualWith(dtb, 1, 2, assert.Message("custom message")) })
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "[EqualWith]")
+		assert.Contains(t, dtb.Logs.String(), "custom message")
+	})
+
+	t.Run("the options only affect the given assertion", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.EqualWith(dtb, []int{1, 2}, []int{2, 1}, assert.UnorderedSlices())
+		assert.False(t, dtb.IsFailed)
+		assert.Should(dtb).Equal([]int{1, 2}, []int{2, 1})
+		assert.True(t, dtb.IsFailed)
+	})
+}
@@ -0,0 +1,156 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.llib.dev/testcase/internal/fmterror"
+	"go.llib.dev/testcase/sandbox"
+)
+
+// Soft runs a block of assertions, where a failing assertion doesn't interrupt the block.
+// Every failed assertion is collected along with its failure message and diff,
+// and at the end of the block, they are reported together.
+//
+// This is useful when you verify many aspects of a value, like the fields of a large DTO,
+// and you want to see all the mismatches at once, not just the first one.
+func (a Asserter) Soft(blk func(a Asserter), msg ...Message) {
+	a.TB.Helper()
+	stb := &softTB{TB: a.TB}
+	ro := sandbox.Run(func() {
+		a.TB.Helper()
+		blk(Should(stb))
+	})
+	if !ro.OK && !ro.Goexit {
+		stb.Log(fmt.Sprintf("panic: %v\n%s", ro.PanicValue, ro.Trace()))
+		stb.Fail()
+	}
+	stb.flush()
+	failures := stb.getFailures()
+	if len(failures) == 0 {
+		pass(a.TB)
+		return
+	}
+	a.TB.Log(fmterror.Message{
+		Name:    "Soft",
+		Cause:   fmt.Sprintf("%d assertion(s) failed in the block.", len(failures)),
+		Message: toMsg(msg),
+	}.String())
+	for i, logs := range failures {
+		a.TB.Logf("\n#%d\n%s", i+1, strings.Join(logs, "\n"))
+	}
+	a.fail()
+}
+
+// softTB collects the logs of the failed assertions, grouped by the assertion that failed.
+//
+// Only the logs of an assertion call are buffered until the assertion passes or fails,
+// the logs of the block itself, like a.TB.Log, are forwarded right away.
+type softTB struct {
+	testing.TB
+
+	mutex    sync.Mutex
+	logs     []string
+	failures [][]string
+}
+
+func (stb *softTB) Log(args ...any) {
+	if !isCalledByAssertion() {
+		stb.TB.Helper()
+		stb.TB.Log(args...)
+		return
+	}
+	stb.mutex.Lock()
+	defer stb.mutex.Unlock()
+	stb.logs = append(stb.logs, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+func (stb *softTB) Logf(format string, args ...any) {
+	if !isCalledByAssertion() {
+		stb.TB.Helper()
+		stb.TB.Logf(format, args...)
+		return
+	}
+	stb.mutex.Lock()
+	defer stb.mutex.Unlock()
+	stb.logs = append(stb.logs, fmt.Sprintf(format, args...))
+}
+
+func (stb *softTB) Error(args ...any) {
+	stb.Log(args...)
+	stb.Fail()
+}
+
+func (stb *softTB) Errorf(format string, args ...any) {
+	stb.Logf(format, args...)
+	stb.Fail()
+}
+
+func (stb *softTB) Fatal(args ...any) {
+	stb.Log(args...)
+	stb.FailNow()
+}
+
+func (stb *softTB) Fatalf(format string, args ...any) {
+	stb.Logf(format, args...)
+	stb.FailNow()
+}
+
+// Fail closes the currently collected logs as the failure of an assertion.
+func (stb *softTB) Fail() {
+	stb.mutex.Lock()
+	defer stb.mutex.Unlock()
+	stb.failures = append(stb.failures, stb.logs)
+	stb.logs = nil
+}
+
+func (stb *softTB) FailNow() {
+	stb.Fail()
+	runtime.Goexit()
+}
+
+func (stb *softTB) Failed() bool {
+	stb.mutex.Lock()
+	defer stb.mutex.Unlock()
+	return 0 < len(stb.failures)
+}
+
+// Pass forwards the logs of the passing assertion, as they are not part of a failure.
+func (stb *softTB) Pass() {
+	stb.flush()
+}
+
+// flush forwards the buffered logs that didn't end up as part of a failure.
+func (stb *softTB) flush() {
+	stb.mutex.Lock()
+	logs := stb.logs
+	stb.logs = nil
+	stb.mutex.Unlock()
+	for _, log := range logs {
+		stb.TB.Log(log)
+	}
+}
+
+func (stb *softTB) getFailures() [][]string {
+	stb.mutex.Lock()
+	defer stb.mutex.Unlock()
+	return stb.failures
+}
+
+var assertPkgPath = reflect.TypeOf(Asserter{}).PkgPath()
+
+// isCalledByAssertion tells whether the softTB's Log or Logf was called from this package,
+// like from an assertion or from softTB's Error and Fatal.
+func isCalledByAssertion() bool {
+	var pcs [1]uintptr
+	// skip runtime.Callers, isCalledByAssertion and softTB's Log/Logf
+	if runtime.Callers(3, pcs[:]) == 0 {
+		return false
+	}
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	return strings.HasPrefix(frame.Function, assertPkgPath+".")
+}
@@ -0,0 +1,116 @@
+package assert_test
+
+import (
+	"strings"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func TestAsserter_Soft(t *testing.T) {
+	t.Run("when every assertion passes, then it passes", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.Equal(1, 1)
+			a.True(true)
+		})
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when assertions fail, then all of them are reported together with their diff", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var reachedTheEnd bool
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.Equal("foo", "bar")
+			a.True(true)
+			a.Equal([]int{1, 2, 3}, []int{1, 2, 4})
+			a.Contains("hello", "world", "custom assertion message")
+			reachedTheEnd = true
+		}, "custom message")
+		assert.True(t, dtb.IsFailed)
+		assert.True(t, reachedTheEnd)
+		logs := dtb.Logs.String()
+		assert.Contains(t, logs, "[Soft]")
+		assert.Contains(t, logs, "3 assertion(s) failed")
+		assert.Contains(t, logs, "custom message")
+		assert.Contains(t, logs, "#1")
+		assert.Contains(t, logs, `"foo"`)
+		assert.Contains(t, logs, `"bar"`)
+		assert.Contains(t, logs, "#2")
+		assert.Contains(t, logs, assert.DiffFunc([]int{1, 2, 3}, []int{1, 2, 4}))
+		assert.Contains(t, logs, "#3")
+		assert.Contains(t, logs, "custom assertion message")
+		assert.NotContains(t, logs, "#4")
+	})
+
+	t.Run("when the block panics, then the panic is reported along with the failed assertions", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.Equal(1, 2)
+			panic("boom")
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "2 assertion(s) failed")
+		assert.Contains(t, dtb.Logs.String(), "panic: boom")
+	})
+
+	t.Run("when the block fails the test directly, then it ends the block", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var reachedTheEnd bool
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.TB.Fatal("fatal")
+			reachedTheEnd = true
+		})
+		assert.True(t, dtb.IsFailed)
+		assert.False(t, reachedTheEnd)
+		assert.Contains(t, dtb.Logs.String(), "fatal")
+	})
+
+	t.Run("when the block logs, then the logs are forwarded right away and not attached to a failure", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.TB.Log("first")
+			a.TB.Logf("%s", "second")
+			assert.Contains(t, dtb.Logs.String(), "first")
+			assert.Contains(t, dtb.Logs.String(), "second")
+			a.Equal(1, 2)
+		})
+		assert.True(t, dtb.IsFailed)
+		logs := dtb.Logs.String()
+		assert.Contains(t, logs, "1 assertion(s) failed")
+		assert.Less(t, strings.Index(logs, "second"), strings.Index(logs, "[Soft]"))
+		assert.Equal(t, 1, strings.Count(logs, "first"))
+		assert.Equal(t, 1, strings.Count(logs, "second"))
+	})
+
+	t.Run("when the block only logs, then the logs are not dropped", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).Soft(func(a assert.Asserter) {
+			a.TB.Log("hello")
+		})
+		assert.False(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "hello")
+	})
+
+	t.Run("package function", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() {
+			assert.Soft(dtb, func(a assert.Asserter) {
+				a.Equal(1, 2)
+				a.Equal(3, 4)
+			})
+		})
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "2 assertion(s) failed")
+	})
+}
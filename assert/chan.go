@@ -0,0 +1,152 @@
+package assert
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// Receive asserts that a value is received from the channel within the timeout duration,
+// and returns the received value.
+//
+//	v := assert.Receive(tb, events, time.Second)
+func Receive[T any](tb testing.TB, ch <-chan T, timeout time.Duration, msg ...Message) T {
+	tb.Helper()
+	a := Must(tb)
+	vs, closed := receive(a, ch, 1, timeout)
+	if len(vs) == 1 {
+		pass(a.TB)
+		return vs[0]
+	}
+	cause := "Expected to receive a value from the channel within the timeout duration."
+	if closed {
+		cause = "The channel was closed before a value was received."
+	}
+	a.failWith(fmterror.Message{
+		Name:    "Receive",
+		Cause:   cause,
+		Message: toMsg(msg),
+		Values:  []fmterror.Value{{Label: "timeout", Value: timeout}},
+	})
+	var zero T
+	return zero
+}
+
+// NotReceive asserts that no value is received from the channel during the duration.
+// A closed channel counts as a receive, as its receivers are no longer blocked.
+func NotReceive[T any](tb testing.TB, ch <-chan T, d time.Duration, msg ...Message) {
+	tb.Helper()
+	a := Must(tb)
+	vs, closed := receive(a, ch, 1, d)
+	if len(vs) == 0 && !closed {
+		pass(a.TB)
+		return
+	}
+	var (
+		cause  = "Expected no value from the channel during the duration."
+		values = []fmterror.Value{{Label: "duration", Value: d}}
+	)
+	if closed {
+		cause = "The channel was closed during the duration."
+	} else {
+		values = append(values, fmterror.Value{Label: "received", Value: vs[0]})
+	}
+	a.failWith(fmterror.Message{
+		Name:    "NotReceive",
+		Cause:   cause,
+		Message: toMsg(msg),
+		Values:  values,
+	})
+}
+
+// Closed asserts that the channel is closed.
+// The check doesn't wait for the channel to be closed,
+// and a value still buffered in the channel fails the assertion, as the channel is expected to be drained.
+func Closed[T any](tb testing.TB, ch <-chan T, msg ...Message) {
+	tb.Helper()
+	a := Must(tb)
+	runtime.Gosched()
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			pass(a.TB)
+			return
+		}
+		a.failWith(fmterror.Message{
+			Name:    "Closed",
+			Cause:   "Expected the channel to be closed, but a value was received from it.",
+			Message: toMsg(msg),
+			Values:  []fmterror.Value{{Label: "received", Value: v}},
+		})
+	default:
+		a.failWith(fmterror.Message{
+			Name:    "Closed",
+			Cause:   "Expected the channel to be closed.",
+			Message: toMsg(msg),
+		})
+	}
+}
+
+// ReceiveAll asserts that n values are received from the channel within the timeout duration,
+// and returns the received values in the order of their receiving.
+func ReceiveAll[T any](tb testing.TB, ch <-chan T, n int, timeout time.Duration, msg ...Message) []T {
+	tb.Helper()
+	a := Must(tb)
+	vs, closed := receive(a, ch, n, timeout)
+	if len(vs) == n {
+		pass(a.TB)
+		return vs
+	}
+	cause := "Expected to receive all the values from the channel within the timeout duration."
+	if closed {
+		cause = "The channel was closed before all the values were received."
+	}
+	a.failWith(fmterror.Message{
+		Name:    "ReceiveAll",
+		Cause:   cause,
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "timeout", Value: timeout},
+			{Label: "expected count", Value: n},
+			{Label: "received", Value: vs},
+		},
+	})
+	return vs
+}
+
+// receive receives up to n values from the channel within the timeout.
+func receive[T any](a Asserter, ch <-chan T, n int, timeout time.Duration) (_ []T, closed bool) {
+	a.TB.Helper()
+	type result struct {
+		Values []T
+		Closed bool
+	}
+	out := make(chan result, 1)
+	a.within(timeout, func(ctx context.Context) {
+		var (
+			r     result
+			timer = time.NewTimer(timeout)
+		)
+		defer timer.Stop()
+		defer func() { out <- r }()
+		for len(r.Values) < n {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					r.Closed = true
+					return
+				}
+				r.Values = append(r.Values, v)
+			case <-timer.C:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	r := <-out // the block returns at the latest when within cancels its context
+	return r.Values, r.Closed
+}
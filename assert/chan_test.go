@@ -0,0 +1,150 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func TestReceive(t *testing.T) {
+	t.Run("when a value is sent within the timeout, then it is returned", func(t *testing.T) {
+		ch := make(chan int)
+		go func() {
+			time.Sleep(time.Millisecond)
+			ch <- 42
+		}()
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var got int
+		out := sandbox.Run(func() { got = assert.Receive(dtb, ch, time.Second) })
+		assert.True(t, out.OK)
+		assert.False(t, dtb.IsFailed)
+		assert.Equal(t, 42, got)
+	})
+
+	t.Run("when no value is sent within the timeout, then it fails", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.Receive(dtb, make(chan int), time.Millisecond, "custom message") })
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "[Receive]")
+		assert.Contains(t, dtb.Logs.String(), "within the timeout")
+		assert.Contains(t, dtb.Logs.String(), "custom message")
+	})
+
+	t.Run("when the channel is closed, then it fails", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.Receive(dtb, ch, time.Second) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "closed")
+	})
+}
+
+func TestNotReceive(t *testing.T) {
+	t.Run("when nothing is sent, then it passes", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.NotReceive(dtb, make(chan int), time.Millisecond) })
+		assert.True(t, out.OK)
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when a value is sent, then it fails with the value", func(t *testing.T) {
+		ch := make(chan string, 1)
+		ch <- "foo"
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.NotReceive(dtb, ch, time.Second) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "[NotReceive]")
+		assert.Contains(t, dtb.Logs.String(), `"foo"`)
+	})
+
+	t.Run("when the channel is closed, then it fails", func(t *testing.T) {
+		ch := make(chan string)
+		close(ch)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.NotReceive(dtb, ch, time.Second) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "closed")
+	})
+}
+
+func TestClosed(t *testing.T) {
+	t.Run("when the channel is closed, then it passes", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.Closed(dtb, ch) })
+		assert.True(t, out.OK)
+	})
+
+	t.Run("when the channel is open, then it fails", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.Closed(dtb, make(chan int)) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "[Closed]")
+	})
+
+	t.Run("when the channel still has a buffered value, then it fails with the value", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 42
+		close(ch)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.Closed(dtb, ch) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "42")
+	})
+}
+
+func TestReceiveAll(t *testing.T) {
+	t.Run("when all the values are sent within the timeout, then they are returned", func(t *testing.T) {
+		ch := make(chan int)
+		go func() {
+			for i := 1; i <= 3; i++ {
+				ch <- i
+			}
+		}()
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		var got []int
+		out := sandbox.Run(func() { got = assert.ReceiveAll(dtb, ch, 3, time.Second) })
+		assert.True(t, out.OK)
+		assert.Equal(t, []int{1, 2, 3}, got)
+	})
+
+	t.Run("when fewer values are sent, then it fails with the received ones", func(t *testing.T) {
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.ReceiveAll(dtb, ch, 3, time.Millisecond) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "[ReceiveAll]")
+		assert.Contains(t, dtb.Logs.String(), "expected count")
+		assert.Contains(t, dtb.Logs.String(), "[]int{")
+	})
+
+	t.Run("when the channel is closed early, then it fails", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 1
+		close(ch)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() { assert.ReceiveAll(dtb, ch, 3, time.Second) })
+		assert.False(t, out.OK)
+		assert.Contains(t, dtb.Logs.String(), "closed before all the values")
+	})
+}
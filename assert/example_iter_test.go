@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package assert_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+)
+
+func ExampleSeqContains() {
+	var tb testing.TB
+	assert.SeqContains(tb, slices.Values([]string{"foo", "bar"}), "bar")
+}
+
+func ExampleSeqContainsExactly() {
+	var tb testing.TB
+	assert.SeqContainsExactly(tb, slices.Values([]int{3, 1, 2}), []int{1, 2, 3})
+}
+
+func ExampleSeqEmpty() {
+	var tb testing.TB
+	assert.SeqEmpty(tb, slices.Values([]int{}))
+}
+
+func ExampleSeq2ContainsExactly() {
+	var tb testing.TB
+	m := map[string]int{"foo": 1, "bar": 2}
+	assert.Seq2ContainsExactly(tb, maps.All(m), map[string]int{"bar": 2, "foo": 1})
+}
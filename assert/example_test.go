@@ -14,6 +14,8 @@ import (
 
 	"go.llib.dev/testcase"
 	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/assert/match"
+	"go.llib.dev/testcase/pp"
 )
 
 func ExampleMust() {
@@ -668,6 +670,14 @@ func Example_configureDiffFunc() {
 	assert.Equal(tb, "foo", "bar")
 }
 
+func Example_configureDiffFuncToStructural() {
+	assert.DiffFunc = pp.DiffStructural[any]
+
+	var tb testing.TB
+	assert.Equal(tb, map[string][]int{"foo": {1, 2, 3}}, map[string][]int{"foo": {1, 2, 4}})
+	// .["foo"][2]: 3 → 4
+}
+
 func ExampleWithin() {
 	var tb testing.TB
 
@@ -850,3 +860,157 @@ func ExampleAsserter_Assert() {
 	var tb testing.TB
 	assert.Must(tb).Assert(true, "explanation why this failure could occured")
 }
+
+func ExampleAsserter_MatchSnapshot() {
+	var tb testing.TB
+	assert.Must(tb).MatchSnapshot(map[string]int{"The Answer": 42})
+}
+
+func ExampleMatchSnapshot() {
+	var tb testing.TB
+	var body = []byte(`{"status":"ok"}`)
+	assert.MatchSnapshot(tb, body, "unexpected API response")
+}
+
+func ExampleAsserter_That() {
+	var tb testing.TB
+	type User struct{ Name string }
+	assert.Must(tb).That([]User{{Name: "Jane"}, {Name: "John"}}, match.AllOf(
+		match.HasLen(2),
+		match.ContainsElementMatching(match.HasField("Name", match.Equal("Jane"))),
+	))
+}
+
+func ExampleThat() {
+	var tb testing.TB
+	var err error
+	assert.That(tb, err, match.ErrorWith(match.Contains("not found")))
+}
+
+func ExampleAsserter_EqualWith() {
+	var tb testing.TB
+	type User struct {
+		Name      string
+		Roles     []string
+		UpdatedAt time.Time
+	}
+	assert.Must(tb).EqualWith(
+		User{Name: "Jane", Roles: []string{"admin", "user"}, UpdatedAt: time.Now()},
+		User{Name: "Jane", Roles: []string{"user", "admin"}},
+		assert.IgnoreFields("UpdatedAt"),
+		assert.UnorderedSlices(),
+		assert.Message("expected that the user is stored"),
+	)
+}
+
+func ExampleEqualWith() {
+	var tb testing.TB
+	assert.EqualWith(tb, 0.1+0.2, 0.3, assert.FloatEpsilon(1e-9))
+}
+
+func ExampleAsserter_Soft() {
+	var tb testing.TB
+	type User struct {
+		Name  string
+		Email string
+		Age   int
+	}
+	var got User
+	assert.Must(tb).Soft(func(a assert.Asserter) {
+		a.Equal(got.Name, "Jane")
+		a.Equal(got.Email, "jane@example.com")
+		a.Equal(got.Age, 42)
+	})
+}
+
+func ExampleSoft() {
+	var tb testing.TB
+	assert.Soft(tb, func(a assert.Asserter) {
+		a.Equal(1, 1)
+		a.Contains("Hello, world!", "world")
+	})
+}
+
+func ExampleAsserter_NoGoroutineLeak() {
+	var tb testing.TB
+	assert.Must(tb).NoGoroutineLeak(func() {
+		done := make(chan struct{})
+		go func() { <-done }()
+		close(done)
+	})
+}
+
+func ExampleNoGoroutineLeak() {
+	var tb testing.TB
+	assert.NoGoroutineLeak(tb, func() {
+		// start and stop your workers here
+	})
+}
+
+func ExampleReceive() {
+	var tb testing.TB
+	events := make(chan string, 1)
+	events <- "created"
+	event := assert.Receive(tb, events, time.Second)
+	_ = event // "created"
+}
+
+func ExampleNotReceive() {
+	var tb testing.TB
+	events := make(chan string)
+	assert.NotReceive(tb, events, 100*time.Millisecond)
+}
+
+func ExampleClosed() {
+	var tb testing.TB
+	done := make(chan struct{})
+	close(done)
+	assert.Closed(tb, done)
+}
+
+func ExampleReceiveAll() {
+	var tb testing.TB
+	events := make(chan int, 3)
+	events <- 1
+	events <- 2
+	events <- 3
+	vs := assert.ReceiveAll(tb, events, 3, time.Second)
+	_ = vs // []int{1, 2, 3}
+}
+
+func ExampleAsserter_Greater() {
+	var tb testing.TB
+	assert.Must(tb).Greater(42, 24)
+}
+
+func ExampleAsserter_Between() {
+	var tb testing.TB
+	assert.Must(tb).Between(42, 1, 100)
+}
+
+func ExampleAsserter_InDelta() {
+	var tb testing.TB
+	assert.Must(tb).InDelta(0.1+0.2, 0.3, 1e-9)
+}
+
+func ExampleAsserter_SortedFunc() {
+	var tb testing.TB
+	vs := []string{"ccc", "bb", "a"}
+	assert.Must(tb).SortedFunc(vs, func(x, y any) int { return len(y.(string)) - len(x.(string)) })
+}
+
+func ExampleSorted() {
+	var tb testing.TB
+	assert.Sorted(tb, []int{1, 2, 2, 3})
+}
+
+func ExampleSortedFunc() {
+	var tb testing.TB
+	type User struct{ Age int }
+	assert.SortedFunc(tb, []User{{Age: 18}, {Age: 42}}, func(a, b User) int { return a.Age - b.Age })
+}
+
+func ExampleMonotonic() {
+	var tb testing.TB
+	assert.Monotonic(tb, []int{5, 3, 3, 1})
+}
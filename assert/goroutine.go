@@ -0,0 +1,37 @@
+package assert
+
+import (
+	"strings"
+	"time"
+
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// goroutineLeakTimeout is how long the goroutines started in the block have to finish after the block returns.
+const goroutineLeakTimeout = time.Second
+
+// NoGoroutineLeak asserts that the goroutines started in the block don't outlive it.
+// After the block returns, the goroutines are given a brief time to finish,
+// and the assertion fails with the stacks of the goroutines that are still running.
+//
+// Goroutines started by tests running in parallel can't be told apart from the leaked ones,
+// thus it is best used in sequential tests.
+func (a Asserter) NoGoroutineLeak(blk func(), msg ...Message) {
+	a.TB.Helper()
+	snapshot := internal.SnapshotGoroutines()
+	blk()
+	leaked := internal.LeakedGoroutines(snapshot, goroutineLeakTimeout)
+	if len(leaked) == 0 {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    "NoGoroutineLeak",
+		Cause:   "Goroutines started in the block are still running.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "leaked goroutines", Value: fmterror.Formatted(strings.Join(leaked, "\n\n"))},
+		},
+	})
+}
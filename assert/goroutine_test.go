@@ -0,0 +1,58 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func TestAsserter_NoGoroutineLeak(t *testing.T) {
+	t.Run("when the block doesn't start goroutines, then it passes", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).NoGoroutineLeak(func() {})
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when the started goroutines finish shortly after the block, then it passes", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).NoGoroutineLeak(func() {
+			go func() { time.Sleep(time.Millisecond) }()
+		})
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when a started goroutine keeps running, then it fails with its stack", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).NoGoroutineLeak(func() {
+			go leakingGoroutine(stop)
+		}, "custom message")
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "[NoGoroutineLeak]")
+		assert.Contains(t, dtb.Logs.String(), "custom message")
+		assert.Contains(t, dtb.Logs.String(), "leakingGoroutine")
+	})
+
+	t.Run("package function", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		out := sandbox.Run(func() {
+			assert.NoGoroutineLeak(dtb, func() { go leakingGoroutine(stop) })
+		})
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+	})
+}
+
+func leakingGoroutine(stop chan struct{}) {
+	<-stop
+}
@@ -0,0 +1,210 @@
+//go:build go1.23
+
+package assert
+
+import (
+	"iter"
+	"testing"
+
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// SeqLimit is the maximum number of elements the iterator assertions consume from an iterator.
+// Yielding more elements fails the assertion, which protects the tests from hanging on an endless iterator.
+var SeqLimit = 10000
+
+// SeqContains asserts that the iterator yields the element.
+func SeqContains[T any](tb testing.TB, seq iter.Seq[T], v T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Contains(collectSeq(tb, "SeqContains", seq), v, msg...)
+}
+
+// SeqContainsExactly asserts that the iterator yields exactly the expected elements, regardless of their order.
+func SeqContainsExactly[T any](tb testing.TB, seq iter.Seq[T], exp []T, msg ...Message) {
+	tb.Helper()
+	Must(tb).ContainsExactly(collectSeq(tb, "SeqContainsExactly", seq), exp, msg...)
+}
+
+// SeqEmpty asserts that the iterator doesn't yield any element.
+func SeqEmpty[T any](tb testing.TB, seq iter.Seq[T], msg ...Message) {
+	tb.Helper()
+	var (
+		first T
+		ok    bool
+	)
+	if seq != nil {
+		seq(func(v T) bool {
+			first, ok = v, true
+			return false
+		})
+	}
+	if !ok {
+		pass(tb)
+		return
+	}
+	Must(tb).failWith(fmterror.Message{
+		Name:    "SeqEmpty",
+		Cause:   "The iterator yielded an element.",
+		Message: toMsg(msg),
+		Values:  []fmterror.Value{{Label: "first element", Value: first}},
+	})
+}
+
+// SeqNotEmpty asserts that the iterator yields at least one element.
+func SeqNotEmpty[T any](tb testing.TB, seq iter.Seq[T], msg ...Message) {
+	tb.Helper()
+	var ok bool
+	if seq != nil {
+		seq(func(T) bool {
+			ok = true
+			return false
+		})
+	}
+	if ok {
+		pass(tb)
+		return
+	}
+	Must(tb).failWith(fmterror.Message{
+		Name:    "SeqNotEmpty",
+		Cause:   "The iterator didn't yield any element.",
+		Message: toMsg(msg),
+	})
+}
+
+// SeqUnique asserts that the iterator doesn't yield the same element twice.
+func SeqUnique[T any](tb testing.TB, seq iter.Seq[T], msg ...Message) {
+	tb.Helper()
+	Must(tb).Unique(collectSeq(tb, "SeqUnique", seq), msg...)
+}
+
+// SeqSub asserts that the iterator yields the needle as a contiguous subsequence.
+func SeqSub[T any](tb testing.TB, seq iter.Seq[T], needle []T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Sub(collectSeq(tb, "SeqSub", seq), needle, msg...)
+}
+
+// Seq2Contains asserts that the iterator yields the key-value pair.
+func Seq2Contains[K, V any](tb testing.TB, seq iter.Seq2[K, V], k K, v V, msg ...Message) {
+	tb.Helper()
+	const method = "Seq2Contains"
+	pairs := collectSeq2(tb, method, seq)
+	for _, p := range pairs {
+		if eq(tb, p.Key, k) && eq(tb, p.Value, v) {
+			pass(tb)
+			return
+		}
+	}
+	Must(tb).failWith(fmterror.Message{
+		Name:    method,
+		Cause:   "The iterator didn't yield the key-value pair.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "key", Value: k},
+			{Label: "value", Value: v},
+			{Label: "yielded pairs", Value: pairs},
+		},
+	})
+}
+
+// Seq2ContainsExactly asserts that the iterator yields exactly the key-value pairs of the expected map.
+func Seq2ContainsExactly[K comparable, V any](tb testing.TB, seq iter.Seq2[K, V], exp map[K]V, msg ...Message) {
+	tb.Helper()
+	const method = "Seq2ContainsExactly"
+	got := make(map[K]V)
+	for _, p := range collectSeq2(tb, method, seq) {
+		if _, ok := got[p.Key]; ok {
+			Must(tb).failWith(fmterror.Message{
+				Name:    method,
+				Cause:   "The iterator yielded the same key more than once.",
+				Message: toMsg(msg),
+				Values:  []fmterror.Value{{Label: "key", Value: p.Key}},
+			})
+			return
+		}
+		got[p.Key] = p.Value
+	}
+	Must(tb).ContainsExactly(got, exp, msg...)
+}
+
+// Seq2Empty asserts that the iterator doesn't yield any key-value pair.
+func Seq2Empty[K, V any](tb testing.TB, seq iter.Seq2[K, V], msg ...Message) {
+	tb.Helper()
+	var (
+		first seqPair[K, V]
+		ok    bool
+	)
+	if seq != nil {
+		seq(func(k K, v V) bool {
+			first, ok = seqPair[K, V]{Key: k, Value: v}, true
+			return false
+		})
+	}
+	if !ok {
+		pass(tb)
+		return
+	}
+	Must(tb).failWith(fmterror.Message{
+		Name:    "Seq2Empty",
+		Cause:   "The iterator yielded a key-value pair.",
+		Message: toMsg(msg),
+		Values:  []fmterror.Value{{Label: "first pair", Value: first}},
+	})
+}
+
+type seqPair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// collectSeq consumes the iterator by calling it directly with a yield function,
+// and fails the test if the iterator yields more elements than the SeqLimit.
+func collectSeq[T any](tb testing.TB, method string, seq iter.Seq[T]) []T {
+	tb.Helper()
+	vs := []T{}
+	if seq == nil {
+		return vs
+	}
+	var exceeded bool
+	seq(func(v T) bool {
+		if SeqLimit <= len(vs) {
+			exceeded = true
+			return false
+		}
+		vs = append(vs, v)
+		return true
+	})
+	if exceeded {
+		failSeqLimit(tb, method)
+	}
+	return vs
+}
+
+func collectSeq2[K, V any](tb testing.TB, method string, seq iter.Seq2[K, V]) []seqPair[K, V] {
+	tb.Helper()
+	pairs := []seqPair[K, V]{}
+	if seq == nil {
+		return pairs
+	}
+	var exceeded bool
+	seq(func(k K, v V) bool {
+		if SeqLimit <= len(pairs) {
+			exceeded = true
+			return false
+		}
+		pairs = append(pairs, seqPair[K, V]{Key: k, Value: v})
+		return true
+	})
+	if exceeded {
+		failSeqLimit(tb, method)
+	}
+	return pairs
+}
+
+func failSeqLimit(tb testing.TB, method string) {
+	tb.Helper()
+	Must(tb).failWith(fmterror.Message{
+		Name:   method,
+		Cause:  "The iterator yielded more elements than assert.SeqLimit, it might be endless.",
+		Values: []fmterror.Value{{Label: "limit", Value: SeqLimit}},
+	})
+}
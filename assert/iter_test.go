@@ -0,0 +1,96 @@
+//go:build go1.23
+
+package assert_test
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func endlessSeq() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestSeqAssertions(t *testing.T) {
+	type TestCase struct {
+		Desc   string
+		Assert func(tb testing.TB)
+		Failed bool
+		Logs   []string
+	}
+	for _, tc := range []TestCase{
+		{Desc: "SeqContains - match", Assert: func(tb testing.TB) { assert.SeqContains(tb, slices.Values([]int{1, 2, 3}), 2) }},
+		{Desc: "SeqContains - mismatch", Assert: func(tb testing.TB) { assert.SeqContains(tb, slices.Values([]int{1, 2, 3}), 4) }, Failed: true},
+		{Desc: "SeqContains - endless", Assert: func(tb testing.TB) { assert.SeqContains(tb, endlessSeq(), -1) }, Failed: true,
+			Logs: []string{"[SeqContains]", "assert.SeqLimit"}},
+
+		{Desc: "SeqContainsExactly - match", Assert: func(tb testing.TB) {
+			assert.SeqContainsExactly(tb, slices.Values([]string{"b", "a"}), []string{"a", "b"})
+		}},
+		{Desc: "SeqContainsExactly - mismatch", Assert: func(tb testing.TB) {
+			assert.SeqContainsExactly(tb, slices.Values([]string{"b", "a"}), []string{"a", "c"})
+		}, Failed: true},
+
+		{Desc: "SeqEmpty - empty", Assert: func(tb testing.TB) { assert.SeqEmpty(tb, slices.Values([]int{})) }},
+		{Desc: "SeqEmpty - nil", Assert: func(tb testing.TB) { assert.SeqEmpty[int](tb, nil) }},
+		{Desc: "SeqEmpty - endless", Assert: func(tb testing.TB) { assert.SeqEmpty(tb, endlessSeq()) }, Failed: true,
+			Logs: []string{"[SeqEmpty]", "first element"}},
+		{Desc: "SeqNotEmpty - endless", Assert: func(tb testing.TB) { assert.SeqNotEmpty(tb, endlessSeq()) }},
+		{Desc: "SeqNotEmpty - empty", Assert: func(tb testing.TB) { assert.SeqNotEmpty(tb, slices.Values([]int{})) }, Failed: true,
+			Logs: []string{"[SeqNotEmpty]"}},
+
+		{Desc: "SeqUnique - unique", Assert: func(tb testing.TB) { assert.SeqUnique(tb, slices.Values([]int{1, 2, 3})) }},
+		{Desc: "SeqUnique - duplicate", Assert: func(tb testing.TB) { assert.SeqUnique(tb, slices.Values([]int{1, 2, 1})) }, Failed: true},
+
+		{Desc: "SeqSub - match", Assert: func(tb testing.TB) { assert.SeqSub(tb, slices.Values([]int{1, 2, 3, 4}), []int{2, 3}) }},
+		{Desc: "SeqSub - mismatch", Assert: func(tb testing.TB) { assert.SeqSub(tb, slices.Values([]int{1, 2, 3, 4}), []int{2, 4}) }, Failed: true},
+
+		{Desc: "Seq2Contains - match", Assert: func(tb testing.TB) {
+			assert.Seq2Contains(tb, maps.All(map[string]int{"a": 1, "b": 2}), "b", 2)
+		}},
+		{Desc: "Seq2Contains - mismatch", Assert: func(tb testing.TB) {
+			assert.Seq2Contains(tb, maps.All(map[string]int{"a": 1, "b": 2}), "b", 3)
+		}, Failed: true, Logs: []string{"[Seq2Contains]", "yielded pairs"}},
+
+		{Desc: "Seq2ContainsExactly - match", Assert: func(tb testing.TB) {
+			assert.Seq2ContainsExactly(tb, maps.All(map[string]int{"a": 1, "b": 2}), map[string]int{"b": 2, "a": 1})
+		}},
+		{Desc: "Seq2ContainsExactly - mismatch", Assert: func(tb testing.TB) {
+			assert.Seq2ContainsExactly(tb, maps.All(map[string]int{"a": 1}), map[string]int{"a": 2})
+		}, Failed: true},
+		{Desc: "Seq2ContainsExactly - duplicate key", Assert: func(tb testing.TB) {
+			assert.Seq2ContainsExactly(tb, slices.All([]int{1, 2}), map[int]int{0: 1, 1: 2})
+			assert.Seq2ContainsExactly(tb, func(yield func(string, int) bool) {
+				_ = yield("a", 1) && yield("a", 1)
+			}, map[string]int{"a": 1})
+		}, Failed: true, Logs: []string{"same key"}},
+
+		{Desc: "Seq2Empty - empty", Assert: func(tb testing.TB) { assert.Seq2Empty(tb, maps.All(map[string]int{})) }},
+		{Desc: "Seq2Empty - not empty", Assert: func(tb testing.TB) { assert.Seq2Empty(tb, maps.All(map[string]int{"a": 1})) }, Failed: true,
+			Logs: []string{"[Seq2Empty]", "first pair"}},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			dtb := &doubles.TB{}
+			defer dtb.Finish()
+			out := sandbox.Run(func() { tc.Assert(dtb) })
+			assert.Equal(t, tc.Failed, !out.OK, assert.Message(dtb.Logs.String()))
+			assert.Equal(t, tc.Failed, dtb.IsFailed)
+			for _, log := range tc.Logs {
+				assert.Contains(t, dtb.Logs.String(), log)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Package match holds composable, self-describing matchers for assert.That.
+//
+//	assert.That(tb, users, match.AllOf(
+//		match.HasLen(3),
+//		match.ContainsElementMatching(match.HasField("Name", match.Equal("Jane"))),
+//	))
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/reflects"
+	"go.llib.dev/testcase/pp"
+	"go.llib.dev/testcase/sandbox"
+)
+
+type matcher struct {
+	describe string
+	match    func(v any) (bool, string)
+}
+
+func (m matcher) Describe() string { return m.describe }
+
+func (m matcher) Match(v any) (bool, string) { return m.match(v) }
+
+// Equal matches values that are equal to the expected value, using the same equality as assert.Equal.
+func Equal(exp any) assert.Matcher {
+	return matcher{
+		describe: "is equal to " + pp.Format(exp),
+		match: func(v any) (bool, string) {
+			if eq, err := reflects.DeepEqual(exp, v); err == nil && eq {
+				return true, ""
+			}
+			return false, "was " + pp.Format(v)
+		},
+	}
+}
+
+// Contains matches values that contain the needle, using the same semantics as assert.Contains.
+// It can check a substring in a string, an element or a sub-slice in a slice, or a sub-map in a map.
+func Contains(needle any) assert.Matcher {
+	return matcher{
+		describe: "contains " + pp.Format(needle),
+		match: func(v any) (bool, string) {
+			dtb := &doubles.TB{}
+			defer dtb.Finish()
+			out := sandbox.Run(func() { assert.Should(dtb).Contains(v, needle) })
+			if out.OK && !dtb.IsFailed {
+				return true, ""
+			}
+			return false, "was " + pp.Format(v)
+		},
+	}
+}
+
+// Not matches values that don't satisfy the given matcher.
+func Not(m assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: "not (" + m.Describe() + ")",
+		match: func(v any) (bool, string) {
+			if ok, _ := m.Match(v); !ok {
+				return true, ""
+			}
+			return false, "was " + pp.Format(v)
+		},
+	}
+}
+
+// AllOf matches values that satisfy all the given matchers.
+func AllOf(ms ...assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: joinDescriptions(ms, " and "),
+		match: func(v any) (bool, string) {
+			var mismatches []string
+			for _, m := range ms {
+				if ok, mismatch := m.Match(v); !ok {
+					mismatches = append(mismatches, fmt.Sprintf("%s: %s", m.Describe(), mismatch))
+				}
+			}
+			if len(mismatches) == 0 {
+				return true, ""
+			}
+			return false, strings.Join(mismatches, "\n")
+		},
+	}
+}
+
+// AnyOf matches values that satisfy at least one of the given matchers.
+func AnyOf(ms ...assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: joinDescriptions(ms, " or "),
+		match: func(v any) (bool, string) {
+			var mismatches []string
+			for _, m := range ms {
+				ok, mismatch := m.Match(v)
+				if ok {
+					return true, ""
+				}
+				mismatches = append(mismatches, fmt.Sprintf("%s: %s", m.Describe(), mismatch))
+			}
+			return false, strings.Join(mismatches, "\n")
+		},
+	}
+}
+
+// HasLen matches strings, slices, arrays, maps and channels with the given length.
+func HasLen(length int) assert.Matcher {
+	return matcher{
+		describe: fmt.Sprintf("has length %d", length),
+		match: func(v any) (bool, string) {
+			rv := reflect.ValueOf(v)
+			switch rv.Kind() {
+			case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+				if rv.Len() == length {
+					return true, ""
+				}
+				return false, fmt.Sprintf("had length %d", rv.Len())
+			default:
+				return false, fmt.Sprintf("was %s, which has no length", typeName(v))
+			}
+		},
+	}
+}
+
+// HasField matches structs, or pointers to structs, where the named field satisfies the given matcher.
+// Nested fields can be referenced with a dot separated path, like "Address.City".
+func HasField(name string, m assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: fmt.Sprintf("has field %s that %s", name, m.Describe()),
+		match: func(v any) (bool, string) {
+			rv := reflect.ValueOf(v)
+			for _, fieldName := range strings.Split(name, ".") {
+				for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+					if rv.IsNil() {
+						return false, fmt.Sprintf("was nil, which has no field %s", fieldName)
+					}
+					rv = rv.Elem()
+				}
+				if rv.Kind() != reflect.Struct {
+					return false, fmt.Sprintf("was %s, which has no field %s", typeName(v), fieldName)
+				}
+				sf, ok := rv.Type().FieldByName(fieldName)
+				if !ok {
+					return false, fmt.Sprintf("%s has no field %s", rv.Type().String(), fieldName)
+				}
+				field, err := rv.FieldByIndexErr(sf.Index)
+				if err != nil {
+					return false, fmt.Sprintf("%s has no field %s, as it is promoted through a nil embedded pointer", rv.Type().String(), fieldName)
+				}
+				rv = reflects.Accessible(field)
+			}
+			if !rv.CanInterface() {
+				return false, fmt.Sprintf("field %s is not accessible", name)
+			}
+			if ok, mismatch := m.Match(rv.Interface()); !ok {
+				return false, fmt.Sprintf("field %s %s", name, mismatch)
+			}
+			return true, ""
+		},
+	}
+}
+
+// HasKey matches maps that have the given key.
+func HasKey(key any) assert.Matcher {
+	return matcher{
+		describe: "has key " + pp.Format(key),
+		match: func(v any) (bool, string) {
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Map {
+				return false, fmt.Sprintf("was %s, which is not a map", typeName(v))
+			}
+			for _, k := range rv.MapKeys() {
+				if eq, err := reflects.DeepEqual(key, reflects.Accessible(k).Interface()); err == nil && eq {
+					return true, ""
+				}
+			}
+			return false, fmt.Sprintf("had the keys %s", pp.Format(mapKeys(rv)))
+		},
+	}
+}
+
+// Each matches slices, arrays and maps where every element satisfies the given matcher.
+// In case of a map, the matcher is applied to the values.
+func Each(m assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: "every element " + m.Describe(),
+		match: func(v any) (bool, string) {
+			elems, ok := elements(v)
+			if !ok {
+				return false, fmt.Sprintf("was %s, which has no elements", typeName(v))
+			}
+			var mismatches []string
+			for _, e := range elems {
+				if ok, mismatch := m.Match(e.Value); !ok {
+					mismatches = append(mismatches, fmt.Sprintf("element [%s] %s", e.Key, mismatch))
+				}
+			}
+			if len(mismatches) == 0 {
+				return true, ""
+			}
+			return false, strings.Join(mismatches, "\n")
+		},
+	}
+}
+
+// ContainsElementMatching matches slices, arrays and maps where at least one element satisfies the given matcher.
+// In case of a map, the matcher is applied to the values.
+func ContainsElementMatching(m assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: "contains an element that " + m.Describe(),
+		match: func(v any) (bool, string) {
+			elems, ok := elements(v)
+			if !ok {
+				return false, fmt.Sprintf("was %s, which has no elements", typeName(v))
+			}
+			for _, e := range elems {
+				if ok, _ := m.Match(e.Value); ok {
+					return true, ""
+				}
+			}
+			return false, fmt.Sprintf("none of the %d elements matched", len(elems))
+		},
+	}
+}
+
+// ErrorWith matches non-nil errors where the error message satisfies the given matcher.
+//
+//	match.ErrorWith(match.Contains("not found"))
+func ErrorWith(m assert.Matcher) assert.Matcher {
+	return matcher{
+		describe: "is an error with a message that " + m.Describe(),
+		match: func(v any) (bool, string) {
+			if v == nil {
+				return false, "was nil"
+			}
+			err, ok := v.(error)
+			if !ok {
+				return false, fmt.Sprintf("was %s, which is not an error", typeName(v))
+			}
+			if ok, mismatch := m.Match(err.Error()); !ok {
+				return false, "error message " + mismatch
+			}
+			return true, ""
+		},
+	}
+}
+
+func joinDescriptions(ms []assert.Matcher, sep string) string {
+	var descriptions []string
+	for _, m := range ms {
+		descriptions = append(descriptions, "("+m.Describe()+")")
+	}
+	return strings.Join(descriptions, sep)
+}
+
+func typeName(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
+
+type element struct {
+	Key   string
+	Value any
+}
+
+func elements(v any) ([]element, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		var out []element
+		for i, l := 0, rv.Len(); i < l; i++ {
+			out = append(out, element{
+				Key:   fmt.Sprintf("%d", i),
+				Value: reflects.Accessible(rv.Index(i)).Interface(),
+			})
+		}
+		return out, true
+	case reflect.Map:
+		var out []element
+		for iter := rv.MapRange(); iter.Next(); {
+			out = append(out, element{
+				Key:   pp.Format(reflects.Accessible(iter.Key()).Interface()),
+				Value: reflects.Accessible(iter.Value()).Interface(),
+			})
+		}
+		sort.Slice(out, func(i, j int) bool {
+			return out[i].Key < out[j].Key
+		})
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// mapKeys returns the keys of the map in a stable order.
+func mapKeys(rv reflect.Value) []any {
+	var keys []any
+	for _, k := range rv.MapKeys() {
+		keys = append(keys, reflects.Accessible(k).Interface())
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return pp.Format(keys[i]) < pp.Format(keys[j])
+	})
+	return keys
+}
@@ -0,0 +1,117 @@
+package match_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/assert/match"
+)
+
+type Address struct {
+	City string
+}
+
+type User struct {
+	Name    string
+	Age     int
+	Address *Address
+	secret  string
+}
+
+type Admin struct {
+	*User
+	Level int
+}
+
+func TestMatchers(t *testing.T) {
+	type TestCase struct {
+		Desc     string
+		Matcher  assert.Matcher
+		Value    any
+		OK       bool
+		Mismatch string
+	}
+
+	jane := User{Name: "Jane", Age: 42, Address: &Address{City: "Budapest"}, secret: "s3cr3t"}
+
+	for _, tc := range []TestCase{
+		{Desc: "Equal - match", Matcher: match.Equal(42), Value: 42, OK: true},
+		{Desc: "Equal - mismatch", Matcher: match.Equal(42), Value: 24, Mismatch: "was 24"},
+		{Desc: "Equal - type mismatch", Matcher: match.Equal(42), Value: int64(42), Mismatch: "was 42"},
+
+		{Desc: "Contains - substring", Matcher: match.Contains("foo"), Value: "-foo-", OK: true},
+		{Desc: "Contains - element", Matcher: match.Contains(2), Value: []int{1, 2, 3}, OK: true},
+		{Desc: "Contains - mismatch", Matcher: match.Contains("bar"), Value: "-foo-", Mismatch: `was "-foo-"`},
+		{Desc: "Contains - invalid", Matcher: match.Contains("bar"), Value: 42, Mismatch: "was 42"},
+
+		{Desc: "Not - match", Matcher: match.Not(match.Equal(42)), Value: 24, OK: true},
+		{Desc: "Not - mismatch", Matcher: match.Not(match.Equal(42)), Value: 42, Mismatch: "was 42"},
+
+		{Desc: "AllOf - match", Matcher: match.AllOf(match.HasLen(3), match.Contains(2)), Value: []int{1, 2, 3}, OK: true},
+		{Desc: "AllOf - mismatch", Matcher: match.AllOf(match.HasLen(2), match.Contains(2), match.Contains(4)), Value: []int{1, 2, 3},
+			Mismatch: "has length 2: had length 3\ncontains 4: was"},
+
+		{Desc: "AnyOf - match", Matcher: match.AnyOf(match.Equal(1), match.Equal(2)), Value: 2, OK: true},
+		{Desc: "AnyOf - mismatch", Matcher: match.AnyOf(match.Equal(1), match.Equal(2)), Value: 3,
+			Mismatch: "is equal to 1: was 3\nis equal to 2: was 3"},
+
+		{Desc: "HasLen - string", Matcher: match.HasLen(3), Value: "foo", OK: true},
+		{Desc: "HasLen - map", Matcher: match.HasLen(1), Value: map[string]int{"foo": 1}, OK: true},
+		{Desc: "HasLen - nil slice", Matcher: match.HasLen(0), Value: []int(nil), OK: true},
+		{Desc: "HasLen - mismatch", Matcher: match.HasLen(3), Value: []int{1}, Mismatch: "had length 1"},
+		{Desc: "HasLen - invalid", Matcher: match.HasLen(3), Value: 42, Mismatch: "was int, which has no length"},
+
+		{Desc: "HasField - match", Matcher: match.HasField("Name", match.Equal("Jane")), Value: jane, OK: true},
+		{Desc: "HasField - pointer", Matcher: match.HasField("Age", match.Equal(42)), Value: &jane, OK: true},
+		{Desc: "HasField - nested", Matcher: match.HasField("Address.City", match.Equal("Budapest")), Value: jane, OK: true},
+		{Desc: "HasField - unexported", Matcher: match.HasField("secret", match.Equal("s3cr3t")), Value: jane, OK: true},
+		{Desc: "HasField - mismatch", Matcher: match.HasField("Name", match.Equal("John")), Value: jane, Mismatch: `field Name was "Jane"`},
+		{Desc: "HasField - missing", Matcher: match.HasField("Email", match.Equal("")), Value: jane, Mismatch: "match_test.User has no field Email"},
+		{Desc: "HasField - nil", Matcher: match.HasField("Address.City", match.Equal("")), Value: User{}, Mismatch: "was nil, which has no field City"},
+		{Desc: "HasField - embedded", Matcher: match.HasField("Name", match.Equal("Jane")), Value: Admin{User: &jane}, OK: true},
+		{Desc: "HasField - nil embedded pointer", Matcher: match.HasField("Name", match.Equal("Jane")), Value: Admin{}, Mismatch: "match_test.Admin has no field Name"},
+		{Desc: "HasField - not a struct", Matcher: match.HasField("Name", match.Equal("")), Value: 42, Mismatch: "was int, which has no field Name"},
+
+		{Desc: "HasKey - match", Matcher: match.HasKey("foo"), Value: map[string]int{"foo": 1}, OK: true},
+		{Desc: "HasKey - mismatch", Matcher: match.HasKey("baz"), Value: map[string]int{"foo": 1, "bar": 2}, Mismatch: "had the keys"},
+		{Desc: "HasKey - invalid", Matcher: match.HasKey("baz"), Value: []int{}, Mismatch: "which is not a map"},
+
+		{Desc: "Each - match", Matcher: match.Each(match.Not(match.Equal(0))), Value: []int{1, 2, 3}, OK: true},
+		{Desc: "Each - empty", Matcher: match.Each(match.Equal(0)), Value: []int{}, OK: true},
+		{Desc: "Each - map", Matcher: match.Each(match.HasLen(1)), Value: map[string]string{"a": "1", "b": "2"}, OK: true},
+		{Desc: "Each - map with nil key", Matcher: match.Each(match.Equal(1)), Value: map[any]int{nil: 1, "a": 1}, OK: true},
+		{Desc: "Each - map with nil key mismatch", Matcher: match.Each(match.Equal(1)), Value: map[any]int{nil: 2}, Mismatch: "element [nil] was 2"},
+		{Desc: "Each - mismatch", Matcher: match.Each(match.Equal(1)), Value: []int{1, 2, 1, 3}, Mismatch: "element [1] was 2\nelement [3] was 3"},
+		{Desc: "Each - invalid", Matcher: match.Each(match.Equal(1)), Value: 1, Mismatch: "which has no elements"},
+
+		{Desc: "ContainsElementMatching - match", Matcher: match.ContainsElementMatching(match.HasField("Name", match.Equal("Jane"))), Value: []User{{Name: "John"}, jane}, OK: true},
+		{Desc: "ContainsElementMatching - mismatch", Matcher: match.ContainsElementMatching(match.Equal(4)), Value: []int{1, 2, 3}, Mismatch: "none of the 3 elements matched"},
+
+		{Desc: "ErrorWith - match", Matcher: match.ErrorWith(match.Contains("not found")), Value: errors.New("user not found"), OK: true},
+		{Desc: "ErrorWith - mismatch", Matcher: match.ErrorWith(match.Equal("boom")), Value: errors.New("bang"), Mismatch: `error message was "bang"`},
+		{Desc: "ErrorWith - nil", Matcher: match.ErrorWith(match.Equal("boom")), Value: nil, Mismatch: "was nil"},
+		{Desc: "ErrorWith - not an error", Matcher: match.ErrorWith(match.Equal("boom")), Value: "boom", Mismatch: "was string, which is not an error"},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			ok, mismatch := tc.Matcher.Match(tc.Value)
+			assert.Equal(t, tc.OK, ok, assert.MessageF("mismatch: %s", mismatch))
+			if !tc.OK {
+				assert.Contains(t, mismatch, tc.Mismatch)
+			}
+		})
+	}
+}
+
+func TestMatchers_Describe(t *testing.T) {
+	m := match.AllOf(
+		match.HasLen(2),
+		match.Each(match.HasField("Name", match.Not(match.Equal("")))),
+		match.AnyOf(match.HasKey("foo"), match.ContainsElementMatching(match.ErrorWith(match.Contains("boom")))),
+	)
+	assert.Equal(t, `(has length 2) and `+
+		`(every element has field Name that not (is equal to "")) and `+
+		`((has key "foo") or (contains an element that is an error with a message that contains "boom"))`,
+		m.Describe())
+}
@@ -0,0 +1,38 @@
+package assert
+
+import (
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// Matcher is a self-describing expectation that can be used with Asserter.That.
+// Matchers can be composed together, which allows to make deep and partial checks on a value.
+//
+// The go.llib.dev/testcase/assert/match package holds the commonly used matchers.
+type Matcher interface {
+	// Describe tells what the Matcher expects from the value.
+	Describe() string
+	// Match checks if the value satisfies the expectation.
+	// When it doesn't, the returned mismatch explains the reason.
+	Match(v any) (ok bool, mismatch string)
+}
+
+// That will check if the value satisfies the expectation of the Matcher.
+// On failure, the message tells what was expected and why the value didn't match.
+func (a Asserter) That(v any, m Matcher, msg ...Message) {
+	a.TB.Helper()
+	ok, mismatch := m.Match(v)
+	if ok {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    "That",
+		Cause:   "The value doesn't match the expectation.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "expected", Value: fmterror.Formatted(m.Describe())},
+			{Label: "mismatch", Value: fmterror.Formatted(mismatch)},
+			{Label: "value", Value: v},
+		},
+	})
+}
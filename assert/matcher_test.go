@@ -0,0 +1,36 @@
+package assert_test
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/assert/match"
+	"go.llib.dev/testcase/internal/doubles"
+)
+
+func TestAsserter_That(t *testing.T) {
+	t.Run("when the value matches, then it passes", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).That([]int{1, 2, 3}, match.AllOf(match.HasLen(3), match.Contains(2)))
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when the value doesn't match, then it fails with the description and the mismatch", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.Should(dtb).That([]int{1, 2, 3}, match.HasLen(2), "custom message")
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "[That]")
+		assert.Contains(t, dtb.Logs.String(), "custom message")
+		assert.Contains(t, dtb.Logs.String(), "has length 2")
+		assert.Contains(t, dtb.Logs.String(), "had length 3")
+	})
+
+	t.Run("package function", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		assert.That(dtb, "foo", match.Equal("foo"))
+		assert.False(t, dtb.IsFailed)
+	})
+}
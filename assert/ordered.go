@@ -0,0 +1,315 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Greater asserts that v is greater than oth.
+// The values must be of the same ordered type, like an integer, a float or a string.
+func (a Asserter) Greater(v, oth any, msg ...Message) {
+	a.TB.Helper()
+	const method = "Greater"
+	c, ok := a.compare(method, v, oth, msg)
+	if !ok {
+		return
+	}
+	if 0 < c {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    method,
+		Cause:   "Expected the value to be greater than the other value.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "value", Value: v},
+			{Label: "other value", Value: oth},
+		},
+	})
+}
+
+// Less asserts that v is less than oth.
+// The values must be of the same ordered type, like an integer, a float or a string.
+func (a Asserter) Less(v, oth any, msg ...Message) {
+	a.TB.Helper()
+	const method = "Less"
+	c, ok := a.compare(method, v, oth, msg)
+	if !ok {
+		return
+	}
+	if c < 0 {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    method,
+		Cause:   "Expected the value to be less than the other value.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "value", Value: v},
+			{Label: "other value", Value: oth},
+		},
+	})
+}
+
+// Between asserts that v is within the inclusive range of min and max.
+// The values must be of the same ordered type, like an integer, a float or a string.
+func (a Asserter) Between(v, min, max any, msg ...Message) {
+	a.TB.Helper()
+	const method = "Between"
+	cMin, ok := a.compare(method, v, min, msg)
+	if !ok {
+		return
+	}
+	cMax, ok := a.compare(method, v, max, msg)
+	if !ok {
+		return
+	}
+	if 0 <= cMin && cMax <= 0 {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    method,
+		Cause:   "Expected the value to be within the range.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "value", Value: v},
+			{Label: "min", Value: min},
+			{Label: "max", Value: max},
+		},
+	})
+}
+
+// InDelta asserts that the difference between v and exp is not greater than delta.
+// The values must be numbers of the same type, while delta is always a float64.
+func (a Asserter) InDelta(v, exp any, delta float64, msg ...Message) {
+	a.TB.Helper()
+	const method = "InDelta"
+	if _, ok := a.compare(method, v, exp, msg); !ok {
+		return
+	}
+	var (
+		f1, ok1 = toFloat64(reflect.ValueOf(v))
+		f2, ok2 = toFloat64(reflect.ValueOf(exp))
+	)
+	if !ok1 || !ok2 {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "Expected numeric values.",
+			Message: toMsg(msg),
+			Values: []fmterror.Value{
+				{Label: "value", Value: v},
+				{Label: "expected", Value: exp},
+			},
+		})
+		return
+	}
+	diff := math.Abs(f1 - f2)
+	if diff <= delta {
+		pass(a.TB)
+		return
+	}
+	a.failWith(fmterror.Message{
+		Name:    method,
+		Cause:   "Expected the difference between the values to be within the delta.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "value", Value: v},
+			{Label: "expected", Value: exp},
+			{Label: "delta", Value: delta},
+			{Label: "difference", Value: diff},
+		},
+	})
+}
+
+// Sorted asserts that the elements of the list are in ascending order.
+// Equal elements next to each other are allowed.
+func (a Asserter) Sorted(list any, msg ...Message) {
+	a.TB.Helper()
+	const method = "Sorted"
+	if _, ok := a.orderedList(method, list, msg); !ok {
+		return
+	}
+	a.sorted(method, list, func(x, y reflect.Value) int {
+		c, _ := compareOrdered(x, y)
+		return c
+	}, msg)
+}
+
+// SortedFunc asserts that the list is sorted according to the cmp function,
+// the same way as slices.IsSortedFunc does.
+// The cmp function receives the list's elements,
+// and returns a negative number when x < y, a positive number when x > y and zero when they are equal.
+func (a Asserter) SortedFunc(list any, cmp func(x, y any) int, msg ...Message) {
+	a.TB.Helper()
+	a.mustBeListType(list)
+	a.sorted("SortedFunc", list, func(x, y reflect.Value) int {
+		return cmp(x.Interface(), y.Interface())
+	}, msg)
+}
+
+func (a Asserter) sorted(method string, list any, cmp func(x, y reflect.Value) int, msg []Message) {
+	a.TB.Helper()
+	rv := reflect.ValueOf(list)
+	for i := 1; i < rv.Len(); i++ {
+		if 0 <= cmp(rv.Index(i), rv.Index(i-1)) {
+			continue
+		}
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "The list is not sorted.",
+			Message: toMsg(msg),
+			Values: []fmterror.Value{
+				{Label: "list", Value: list},
+				{Label: "index", Value: i},
+				{Label: "previous element", Value: rv.Index(i - 1).Interface()},
+				{Label: "element", Value: rv.Index(i).Interface()},
+			},
+		})
+		return
+	}
+	pass(a.TB)
+}
+
+// Monotonic asserts that the elements of the list are either all non-decreasing or all non-increasing,
+// thus the sequence doesn't change direction.
+func (a Asserter) Monotonic(list any, msg ...Message) {
+	a.TB.Helper()
+	const method = "Monotonic"
+	vs, ok := a.orderedList(method, list, msg)
+	if !ok {
+		return
+	}
+	var direction int
+	for i := 1; i < vs.Len(); i++ {
+		c, _ := compareOrdered(vs.Index(i), vs.Index(i-1))
+		if c == 0 {
+			continue
+		}
+		if direction == 0 {
+			direction = c
+			continue
+		}
+		if direction == c {
+			continue
+		}
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "The list changes direction.",
+			Message: toMsg(msg),
+			Values: []fmterror.Value{
+				{Label: "list", Value: list},
+				{Label: "index", Value: i},
+				{Label: "previous element", Value: vs.Index(i - 1).Interface()},
+				{Label: "element", Value: vs.Index(i).Interface()},
+			},
+		})
+		return
+	}
+	pass(a.TB)
+}
+
+func (a Asserter) compare(method string, v, oth any, msg []Message) (int, bool) {
+	a.TB.Helper()
+	if a.checkTypeEquality(method, v, oth, msg) {
+		return 0, false
+	}
+	c, ok := compareOrdered(reflect.ValueOf(v), reflect.ValueOf(oth))
+	if !ok {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "The values are not comparable.",
+			Message: toMsg(msg),
+			Values: []fmterror.Value{
+				{Label: "value", Value: v},
+				{Label: "other value", Value: oth},
+			},
+		})
+		return 0, false
+	}
+	return c, true
+}
+
+func (a Asserter) orderedList(method string, list any, msg []Message) (reflect.Value, bool) {
+	a.TB.Helper()
+	a.mustBeListType(list)
+	vs := reflect.ValueOf(list)
+	if !isOrderedKind(vs.Type().Elem().Kind()) {
+		Must(a.TB).True(false, Message(fmt.Sprintf("unexpected element type: %s", vs.Type().Elem().String())))
+		return vs, false
+	}
+	for i := 0; i < vs.Len(); i++ {
+		if f, ok := toFloat64(vs.Index(i)); ok && math.IsNaN(f) {
+			a.failWith(fmterror.Message{
+				Name:    method,
+				Cause:   "The list contains a NaN value, which is not comparable.",
+				Message: toMsg(msg),
+				Values: []fmterror.Value{
+					{Label: "list", Value: list},
+					{Label: "index", Value: i},
+				},
+			})
+			return vs, false
+		}
+	}
+	return vs, true
+}
+
+func isOrderedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// compareOrdered compares two values of the same ordered type.
+// NaN values are not comparable.
+func compareOrdered(v1, v2 reflect.Value) (int, bool) {
+	if !v1.IsValid() || !v2.IsValid() || v1.Type() != v2.Type() || !isOrderedKind(v1.Kind()) {
+		return 0, false
+	}
+	switch {
+	case v1.CanInt():
+		return cmp.Compare(v1.Int(), v2.Int()), true
+	case v1.CanUint():
+		return cmp.Compare(v1.Uint(), v2.Uint()), true
+	case v1.CanFloat():
+		f1, f2 := v1.Float(), v2.Float()
+		if math.IsNaN(f1) || math.IsNaN(f2) {
+			return 0, false
+		}
+		return cmp.Compare(f1, f2), true
+	default:
+		return cmp.Compare(v1.String(), v2.String()), true
+	}
+}
+
+func toFloat64(rv reflect.Value) (float64, bool) {
+	switch {
+	case rv.CanInt():
+		return float64(rv.Int()), true
+	case rv.CanUint():
+		return float64(rv.Uint()), true
+	case rv.CanFloat():
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
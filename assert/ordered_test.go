@@ -0,0 +1,94 @@
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
+)
+
+type orderedLevel int
+
+func TestAsserter_orderedComparisons(t *testing.T) {
+	type TestCase struct {
+		Desc   string
+		Assert func(a assert.Asserter)
+		Failed bool
+		Logs   []string
+	}
+	for _, tc := range []TestCase{
+		{Desc: "Greater - greater", Assert: func(a assert.Asserter) { a.Greater(2, 1) }},
+		{Desc: "Greater - equal", Assert: func(a assert.Asserter) { a.Greater(1, 1) }, Failed: true,
+			Logs: []string{"[Greater]", "value:", "other value:"}},
+		{Desc: "Greater - less", Assert: func(a assert.Asserter) { a.Greater("a", "b") }, Failed: true, Logs: []string{`"a"`, `"b"`}},
+		{Desc: "Greater - named type", Assert: func(a assert.Asserter) { a.Greater(orderedLevel(3), orderedLevel(2)) }},
+		{Desc: "Greater - type mismatch", Assert: func(a assert.Asserter) { a.Greater(2, int64(1)) }, Failed: true, Logs: []string{"incorrect types"}},
+		{Desc: "Greater - NaN", Assert: func(a assert.Asserter) { a.Greater(math.NaN(), 1.0) }, Failed: true, Logs: []string{"not comparable"}},
+		{Desc: "Greater - not ordered", Assert: func(a assert.Asserter) { a.Greater(true, false) }, Failed: true, Logs: []string{"not comparable"}},
+
+		{Desc: "Less - less", Assert: func(a assert.Asserter) { a.Less(uint8(1), uint8(2)) }},
+		{Desc: "Less - greater", Assert: func(a assert.Asserter) { a.Less(2.5, 1.5) }, Failed: true, Logs: []string{"[Less]", "2.5", "1.5"}},
+
+		{Desc: "Between - inside", Assert: func(a assert.Asserter) { a.Between(5, 1, 10) }},
+		{Desc: "Between - on the edges", Assert: func(a assert.Asserter) { a.Between(1, 1, 10); a.Between(10, 1, 10) }},
+		{Desc: "Between - outside", Assert: func(a assert.Asserter) { a.Between(11, 1, 10) }, Failed: true, Logs: []string{"[Between]", "min:", "max:"}},
+
+		{Desc: "InDelta - within", Assert: func(a assert.Asserter) { a.InDelta(1.05, 1.0, 0.1) }},
+		{Desc: "InDelta - integers", Assert: func(a assert.Asserter) { a.InDelta(98, 100, 2) }},
+		{Desc: "InDelta - outside", Assert: func(a assert.Asserter) { a.InDelta(1.5, 1.0, 0.1) }, Failed: true, Logs: []string{"[InDelta]", "delta:", "difference:"}},
+		{Desc: "InDelta - strings", Assert: func(a assert.Asserter) { a.InDelta("a", "b", 1) }, Failed: true, Logs: []string{"numeric"}},
+
+		{Desc: "Sorted - sorted", Assert: func(a assert.Asserter) { a.Sorted([]int{1, 2, 2, 3}) }},
+		{Desc: "Sorted - empty", Assert: func(a assert.Asserter) { a.Sorted([]string{}) }},
+		{Desc: "Sorted - array", Assert: func(a assert.Asserter) { a.Sorted([3]string{"a", "b", "c"}) }},
+		{Desc: "Sorted - not sorted", Assert: func(a assert.Asserter) { a.Sorted([]int{1, 3, 2}) }, Failed: true,
+			Logs: []string{"[Sorted]", "index:\t2", "previous element:\t3", "element:\t2"}},
+		{Desc: "Sorted - NaN", Assert: func(a assert.Asserter) { a.Sorted([]float64{1, math.NaN()}) }, Failed: true, Logs: []string{"NaN"}},
+
+		{Desc: "SortedFunc - sorted", Assert: func(a assert.Asserter) {
+			vs := []string{"ccc", "bb", "a"}
+			a.SortedFunc(vs, func(x, y any) int { return len(y.(string)) - len(x.(string)) })
+		}},
+		{Desc: "SortedFunc - not sorted", Assert: func(a assert.Asserter) {
+			vs := []string{"a", "ccc", "bb"}
+			a.SortedFunc(vs, func(x, y any) int { return len(x.(string)) - len(y.(string)) })
+		}, Failed: true, Logs: []string{"[SortedFunc]", `"ccc"`, `"bb"`}},
+
+		{Desc: "Monotonic - increasing", Assert: func(a assert.Asserter) { a.Monotonic([]int{1, 1, 2, 5}) }},
+		{Desc: "Monotonic - decreasing", Assert: func(a assert.Asserter) { a.Monotonic([]int{5, 3, 3, 1}) }},
+		{Desc: "Monotonic - changes direction", Assert: func(a assert.Asserter) { a.Monotonic([]int{1, 2, 1}) }, Failed: true,
+			Logs: []string{"[Monotonic]", "changes direction", "index:\t2"}},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			dtb := &doubles.TB{}
+			defer dtb.Finish()
+			sandbox.Run(func() { tc.Assert(assert.Should(dtb)) })
+			assert.Equal(t, tc.Failed, dtb.IsFailed, assert.Message(dtb.Logs.String()))
+			for _, log := range tc.Logs {
+				assert.Contains(t, dtb.Logs.String(), log)
+			}
+		})
+	}
+}
+
+func TestOrderedComparisons_packageFunctions(t *testing.T) {
+	dtb := &doubles.TB{}
+	defer dtb.Finish()
+	out := sandbox.Run(func() {
+		assert.Greater(dtb, 2, 1)
+		assert.Less(dtb, "a", "b")
+		assert.Between(dtb, orderedLevel(2), 1, 3)
+		assert.InDelta(dtb, float32(1.1), 1.0, 0.2)
+		assert.Sorted(dtb, []int{1, 2, 3})
+		assert.SortedFunc(dtb, []int{3, 2, 1}, func(a, b int) int { return b - a })
+		assert.Monotonic(dtb, []int{3, 2, 1})
+	})
+	assert.True(t, out.OK, assert.Message(dtb.Logs.String()))
+
+	out = sandbox.Run(func() { assert.Greater(dtb, 1, 2) })
+	assert.False(t, out.OK)
+	assert.True(t, dtb.IsFailed)
+}
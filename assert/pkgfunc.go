@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"cmp"
 	"context"
 	"io"
 	"testing"
@@ -57,6 +58,12 @@ func Equal[T any](tb testing.TB, v, oth T, msg ...Message) {
 	Must(tb).Equal(v, oth, msg...)
 }
 
+// EqualWith works like Equal, but the comparison can be altered with EqualOption(s).
+func EqualWith[T any](tb testing.TB, v, oth T, opts ...EqualOption) {
+	tb.Helper()
+	Must(tb).EqualWith(v, oth, opts...)
+}
+
 func NotEqual[T any](tb testing.TB, v, oth T, msg ...Message) {
 	tb.Helper()
 	Must(tb).NotEqual(v, oth, msg...)
@@ -140,3 +147,62 @@ func NotUnique[T any](tb testing.TB, vs []T, msg ...Message) {
 	tb.Helper()
 	Must(tb).NotUnique(vs, msg...)
 }
+
+func MatchSnapshot(tb testing.TB, v any, msg ...Message) {
+	tb.Helper()
+	Must(tb).MatchSnapshot(v, msg...)
+}
+
+// That will check if the value satisfies the expectation of the Matcher.
+func That(tb testing.TB, v any, m Matcher, msg ...Message) {
+	tb.Helper()
+	Must(tb).That(v, m, msg...)
+}
+
+// Soft runs a block of assertions, and reports all the failed assertions together at the end of the block.
+func Soft(tb testing.TB, blk func(a Asserter), msg ...Message) {
+	tb.Helper()
+	Must(tb).Soft(blk, msg...)
+}
+
+func NoGoroutineLeak(tb testing.TB, blk func(), msg ...Message) {
+	tb.Helper()
+	Must(tb).NoGoroutineLeak(blk, msg...)
+}
+
+func Greater[T cmp.Ordered](tb testing.TB, v, oth T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Greater(v, oth, msg...)
+}
+
+func Less[T cmp.Ordered](tb testing.TB, v, oth T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Less(v, oth, msg...)
+}
+
+func Between[T cmp.Ordered](tb testing.TB, v, min, max T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Between(v, min, max, msg...)
+}
+
+func InDelta[T number](tb testing.TB, v, exp T, delta float64, msg ...Message) {
+	tb.Helper()
+	Must(tb).InDelta(v, exp, delta, msg...)
+}
+
+func Sorted[T cmp.Ordered](tb testing.TB, vs []T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Sorted(vs, msg...)
+}
+
+// SortedFunc asserts that the list is sorted according to the cmp function,
+// the same way as slices.IsSortedFunc does.
+func SortedFunc[T any](tb testing.TB, vs []T, cmp func(a, b T) int, msg ...Message) {
+	tb.Helper()
+	Must(tb).SortedFunc(vs, func(x, y any) int { return cmp(x.(T), y.(T)) }, msg...)
+}
+
+func Monotonic[T cmp.Ordered](tb testing.TB, vs []T, msg ...Message) {
+	tb.Helper()
+	Must(tb).Monotonic(vs, msg...)
+}
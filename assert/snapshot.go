@@ -0,0 +1,207 @@
+package assert
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/internal/fmterror"
+	"go.llib.dev/testcase/pp"
+)
+
+// snapshotDir is the directory where the snapshots are stored, relative to the package of the test.
+var snapshotDir = filepath.Join("testdata", "__snapshots__")
+
+// MatchSnapshot will compare the value with the snapshot stored for the current test.
+// The snapshot is stored under "testdata/__snapshots__", keyed by the test's name, which includes its context path.
+// When a test makes multiple snapshots, they are numbered in the order of the assertions.
+//
+// Strings and byte slices are stored as they are, while other values are stored in pp.Format's format.
+// When the snapshot doesn't exist yet, the assertion fails.
+//
+// To create the missing snapshots or rewrite the stored ones with the current values,
+// set TESTCASE_UPDATE_SNAPSHOTS=true, or define and set an "-update" boolean test flag.
+func (a Asserter) MatchSnapshot(v any, msg ...Message) {
+	a.TB.Helper()
+	const method = "MatchSnapshot"
+
+	var (
+		path    = snapshotPath(a.TB)
+		current = snapshotFormat(v)
+	)
+
+	update, err := isSnapshotUpdate()
+	if err != nil {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   err.Error(),
+			Message: toMsg(msg),
+		})
+		return
+	}
+
+	if update {
+		if err := writeSnapshot(path, current); err != nil {
+			a.failWith(fmterror.Message{
+				Name:    method,
+				Cause:   "Failed to write the snapshot.",
+				Message: toMsg(msg),
+				Values:  []fmterror.Value{{Label: "error", Value: fmterror.Formatted(err.Error())}},
+			})
+			return
+		}
+		a.TB.Logf("snapshot written: %s", path)
+		pass(a.TB)
+		return
+	}
+	stored, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "The snapshot doesn't exist.",
+			Message: toMsg(msg),
+			Values: []fmterror.Value{
+				{Label: "snapshot", Value: fmterror.Formatted(path)},
+				{Label: "update", Value: fmterror.Formatted(environ.KeyUpdateSnapshots + "=true")},
+			},
+		})
+		return
+	}
+	if err != nil {
+		a.failWith(fmterror.Message{
+			Name:    method,
+			Cause:   "Failed to read the snapshot.",
+			Message: toMsg(msg),
+			Values:  []fmterror.Value{{Label: "error", Value: fmterror.Formatted(err.Error())}},
+		})
+		return
+	}
+
+	if string(stored) == current {
+		pass(a.TB)
+		return
+	}
+
+	a.TB.Log(fmterror.Message{
+		Name:    method,
+		Cause:   "The value doesn't match the stored snapshot.",
+		Message: toMsg(msg),
+		Values: []fmterror.Value{
+			{Label: "snapshot", Value: fmterror.Formatted(path)},
+			{Label: "update", Value: fmterror.Formatted(environ.KeyUpdateSnapshots + "=true")},
+		},
+	}.String())
+	a.TB.Logf("\n\n%s", pp.DiffString(string(stored), current))
+	a.fail()
+}
+
+func snapshotFormat(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		f := pp.DefaultFormatter
+		f.Color = false // escape sequences don't belong to the stored snapshots
+		return f.Format(v)
+	}
+}
+
+func writeSnapshot(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+func isSnapshotUpdate() (bool, error) {
+	if f := flag.Lookup("update"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if update, ok := getter.Get().(bool); ok && update {
+				return true, nil
+			}
+		}
+	}
+	raw, ok := os.LookupEnv(environ.KeyUpdateSnapshots)
+	if !ok || raw == "" {
+		return false, nil
+	}
+	update, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s has an invalid boolean value: %q", environ.KeyUpdateSnapshots, raw)
+	}
+	return update, nil
+}
+
+// snapshotCounters count the snapshots per testing.TB,
+// so every attempt of a retried test, like with Eventually or testcase.Flaky, starts from its first snapshot.
+var snapshotCounters = struct {
+	mutex  sync.Mutex
+	counts map[internal.TBKey]*snapshotCounter
+}{counts: map[internal.TBKey]*snapshotCounter{}}
+
+type snapshotCounter struct {
+	tb testing.TB // keeps the testing.TB's address in use while it is counted
+	n  int
+}
+
+// snapshotPath returns the path of the next snapshot of the test.
+// The test's name segments become directories, so the snapshots follow the context path of the test.
+func snapshotPath(tb testing.TB) string {
+	tb.Helper()
+	var (
+		testName = tb.Name()
+		key      = internal.KeyOfTB(tb)
+	)
+	snapshotCounters.mutex.Lock()
+	counter, ok := snapshotCounters.counts[key]
+	if !ok {
+		counter = &snapshotCounter{tb: tb}
+		snapshotCounters.counts[key] = counter
+	}
+	counter.n++
+	n := counter.n
+	snapshotCounters.mutex.Unlock()
+	if n == 1 {
+		tb.Cleanup(func() {
+			snapshotCounters.mutex.Lock()
+			defer snapshotCounters.mutex.Unlock()
+			delete(snapshotCounters.counts, key)
+		})
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(testName, "/") {
+		segments = append(segments, snapshotSanitize(segment))
+	}
+	name := filepath.Join(segments...)
+	if 1 < n {
+		name = fmt.Sprintf("%s.%d", name, n)
+	}
+	return filepath.Join(snapshotDir, name+".snap")
+}
+
+func snapshotSanitize(segment string) string {
+	segment = strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20, strings.ContainsRune(`<>:"\|?*`, r):
+			return '_'
+		default:
+			return r
+		}
+	}, segment)
+	if segment == "" || segment == "." || segment == ".." {
+		return "_" + segment
+	}
+	return segment
+}
@@ -0,0 +1,156 @@
+package assert_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+)
+
+func chdirToTempDir(tb testing.TB) string {
+	tb.Helper()
+	wd, err := os.Getwd()
+	assert.NoError(tb, err)
+	dir := tb.TempDir()
+	assert.NoError(tb, os.Chdir(dir))
+	tb.Cleanup(func() { _ = os.Chdir(wd) })
+	return dir
+}
+
+func snapshot(tb testing.TB, name string, vs ...any) *doubles.TB {
+	tb.Helper()
+	dtb := &doubles.TB{StubName: name}
+	for _, v := range vs {
+		assert.Should(dtb).MatchSnapshot(v)
+	}
+	dtb.Finish()
+	return dtb
+}
+
+// record stores the values as the snapshots of the test, using the update mode.
+func record(tb testing.TB, name string, vs ...any) {
+	tb.Helper()
+	tb.Setenv(environ.KeyUpdateSnapshots, "true")
+	defer tb.Setenv(environ.KeyUpdateSnapshots, "")
+	assert.False(tb, snapshot(tb, name, vs...).IsFailed)
+}
+
+func TestMatchSnapshot(t *testing.T) {
+	type Response struct {
+		Code int
+		Body string
+	}
+
+	t.Run("when the snapshot doesn't exist, then it fails without creating it", func(t *testing.T) {
+		dir := chdirToTempDir(t)
+		dtb := snapshot(t, "TestX", Response{Code: 200})
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "The snapshot doesn't exist.")
+		assert.Contains(t, dtb.Logs.String(), filepath.Join("testdata", "__snapshots__", "TestX.snap"))
+		assert.Contains(t, dtb.Logs.String(), environ.KeyUpdateSnapshots)
+		_, err := os.Stat(filepath.Join(dir, "testdata", "__snapshots__", "TestX.snap"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("when the snapshot doesn't exist and update mode is enabled, then it is created", func(t *testing.T) {
+		dir := chdirToTempDir(t)
+		record(t, "TestX/when_ctx/then", Response{Code: 200, Body: "OK"})
+		content, err := os.ReadFile(filepath.Join(dir, "testdata", "__snapshots__", "TestX", "when_ctx", "then.snap"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "Code: 200")
+		assert.Contains(t, string(content), `Body: "OK"`)
+	})
+
+	t.Run("when the value matches the stored snapshot, then it passes", func(t *testing.T) {
+		chdirToTempDir(t)
+		record(t, "TestX", Response{Code: 200})
+		assert.False(t, snapshot(t, "TestX", Response{Code: 200}).IsFailed)
+	})
+
+	t.Run("when the value differs from the stored snapshot, then it fails with a diff", func(t *testing.T) {
+		chdirToTempDir(t)
+		record(t, "TestX", Response{Code: 200})
+		dtb := snapshot(t, "TestX", Response{Code: 500})
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), filepath.Join("testdata", "__snapshots__", "TestX.snap"))
+		assert.Contains(t, dtb.Logs.String(), "Code: 200")
+		assert.Contains(t, dtb.Logs.String(), "Code: 500")
+		assert.Contains(t, dtb.Logs.String(), environ.KeyUpdateSnapshots)
+	})
+
+	t.Run("when update mode is enabled, then the stored snapshot is rewritten", func(t *testing.T) {
+		chdirToTempDir(t)
+		record(t, "TestX", Response{Code: 200})
+		t.Setenv(environ.KeyUpdateSnapshots, "true")
+		assert.False(t, snapshot(t, "TestX", Response{Code: 500}).IsFailed)
+		t.Setenv(environ.KeyUpdateSnapshots, "false")
+		assert.False(t, snapshot(t, "TestX", Response{Code: 500}).IsFailed)
+		assert.True(t, snapshot(t, "TestX", Response{Code: 200}).IsFailed)
+	})
+
+	t.Run("when update mode has an invalid value, then it fails", func(t *testing.T) {
+		chdirToTempDir(t)
+		t.Setenv(environ.KeyUpdateSnapshots, "yes please")
+		dtb := snapshot(t, "TestX", Response{Code: 200})
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), environ.KeyUpdateSnapshots)
+	})
+
+	t.Run("when a test makes multiple snapshots, then each is stored separately", func(t *testing.T) {
+		dir := chdirToTempDir(t)
+		record(t, "TestX", "first", "second")
+		assert.False(t, snapshot(t, "TestX", "first", "second").IsFailed)
+		assert.True(t, snapshot(t, "TestX", "first", "third").IsFailed)
+		first, err := os.ReadFile(filepath.Join(dir, "testdata", "__snapshots__", "TestX.snap"))
+		assert.NoError(t, err)
+		assert.Equal(t, "first", string(first))
+		second, err := os.ReadFile(filepath.Join(dir, "testdata", "__snapshots__", "TestX.2.snap"))
+		assert.NoError(t, err)
+		assert.Equal(t, "second", string(second))
+	})
+
+	t.Run("when the value is a byte slice, then it is stored as is", func(t *testing.T) {
+		dir := chdirToTempDir(t)
+		record(t, "TestX", []byte(`{"foo":"bar"}`))
+		content, err := os.ReadFile(filepath.Join(dir, "testdata", "__snapshots__", "TestX.snap"))
+		assert.NoError(t, err)
+		assert.Equal(t, `{"foo":"bar"}`, string(content))
+	})
+	t.Run("when a test with multiple snapshots is retried, then every attempt starts from its first snapshot", func(t *testing.T) {
+		dir := chdirToTempDir(t)
+		record(t, "TestX", "first", "second")
+		dtb := &doubles.TB{StubName: "TestX"}
+		var attempts int
+		assert.Retry{Strategy: assert.RetryCount(3)}.Assert(dtb, func(t testing.TB) {
+			attempts++
+			assert.Should(t).MatchSnapshot("first")
+			assert.Should(t).MatchSnapshot("second")
+			assert.Should(t).True(3 <= attempts)
+		})
+		dtb.Finish()
+		assert.False(t, dtb.IsFailed)
+		assert.Equal(t, 3, attempts)
+		_, err := os.Stat(filepath.Join(dir, "testdata", "__snapshots__", "TestX.3.snap"))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("when the testing.TB is a value type wrapper that is not hashable, then it works", func(t *testing.T) {
+		type ValueTB struct {
+			testing.TB
+			tags []string
+		}
+		dir := chdirToTempDir(t)
+		t.Setenv(environ.KeyUpdateSnapshots, "true")
+		dtb := &doubles.TB{StubName: "TestX"}
+		tb := ValueTB{TB: dtb, tags: []string{"foo"}}
+		assert.Should(tb).MatchSnapshot("first")
+		assert.Should(tb).MatchSnapshot("second")
+		dtb.Finish()
+		assert.False(t, dtb.IsFailed)
+		second, err := os.ReadFile(filepath.Join(dir, "testdata", "__snapshots__", "TestX.2.snap"))
+		assert.NoError(t, err)
+		assert.Equal(t, "second", string(second))
+	})
+}
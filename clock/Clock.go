@@ -1,6 +1,7 @@
 package clock
 
 import (
+	"context"
 	"time"
 
 	"go.llib.dev/testcase/clock/internal"
@@ -37,6 +38,48 @@ func Since(start time.Time) time.Duration {
 	return internal.SinceFunc(start)
 }
 
+// Until returns the duration until t.
+// It is shorthand for t.Sub(clock.Now()).
+//
+// During testing, Until will react to time travelling.
+func Until(t time.Time) time.Duration {
+	return internal.UntilFunc(t)
+}
+
+// NewTimer creates a new Timer that will send
+// the current time on its channel after at least duration d.
+//
+// During testing, Timer will react to time travelling.
+func NewTimer(d time.Duration) *Timer {
+	return internal.NewTimerFunc(d)
+}
+
+// AfterFunc waits for the duration to elapse and then calls f in its own goroutine.
+// It returns a Timer that can be used to cancel the call using its Stop method.
+// The returned Timer's C field is not used and will be nil.
+//
+// During testing, AfterFunc will react to time travelling.
+func AfterFunc(d time.Duration, f func()) *Timer {
+	return internal.AfterFuncFunc(d, f)
+}
+
+// WithDeadline returns a copy of the parent context with the deadline adjusted to be no later than d.
+// The returned context's Done channel is closed when the deadline expires,
+// when the returned cancel function is called, or when the parent context's Done channel is closed,
+// whichever happens first.
+//
+// During testing, the deadline expires by the clock, so it will react to time travelling.
+func WithDeadline(parent context.Context, d time.Time) (context.Context, context.CancelFunc) {
+	return internal.WithDeadlineFunc(parent, d)
+}
+
+// WithTimeout returns WithDeadline(parent, clock.Now().Add(timeout)).
+//
+// During testing, the timeout expires by the clock, so it will react to time travelling.
+func WithTimeout(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return WithDeadline(parent, Now().Add(timeout))
+}
+
 // NewTicker returns a new Ticker containing a channel that will send
 // the current time on the channel after each tick. The period of the
 // ticks is specified by the duration argument. The ticker will adjust
@@ -53,3 +96,8 @@ func NewTicker(d time.Duration) *Ticker {
 // During testing, it will be a clock-based ticker that can time travel,
 // and outside of testing, it will use the time.Ticker.
 type Ticker = internal.Ticker
+
+// Timer acts as a proxy between the caller and the timer implementation.
+// During testing, it will be a clock-based timer that can time travel,
+// and outside of testing, it will use the time.Timer.
+type Timer = internal.Timer
@@ -704,3 +704,396 @@ func TestSince(t *testing.T) {
 		assert.True(t, got2 < 0)
 	})
 }
+
+func TestUntil(t *testing.T) {
+	s := testcase.NewSpec(t)
+	s.HasSideEffect()
+
+	var (
+		target = let.Var[time.Time](s, nil)
+	)
+	act := let.Act(func(t *testcase.T) time.Duration {
+		return clock.Until(target.Get(t))
+	})
+
+	s.When("timecop mocked Now()", func(s *testcase.Spec) {
+		var (
+			now = let.Var(s, func(t *testcase.T) time.Time {
+				n := t.Random.Time()
+				timecop.Travel(t, n, timecop.Freeze)
+				return n
+			})
+			duration = let.DurationBetween(s, time.Second, time.Hour)
+		)
+
+		s.And("target time is in the future", func(s *testcase.Spec) {
+			target.Let(s, func(t *testcase.T) time.Time {
+				return now.Get(t).Add(duration.Get(t))
+			})
+
+			s.Then("the remaining duration is returned", func(t *testcase.T) {
+				assert.Equal(t, duration.Get(t), act(t))
+			})
+		})
+
+		s.And("target time is in the past", func(s *testcase.Spec) {
+			target.Let(s, func(t *testcase.T) time.Time {
+				return now.Get(t).Add(duration.Get(t) * -1)
+			})
+
+			s.Then("duration is negative", func(t *testcase.T) {
+				assert.Equal(t, duration.Get(t)*-1, act(t))
+			})
+		})
+	})
+
+	s.Test("parity with time.Until", func(t *testcase.T) {
+		future := time.Now().Add(time.Hour)
+		assert.True(t, clock.Until(future) <= time.Until(future)+BufferTime)
+		assert.True(t, time.Hour-BufferTime <= clock.Until(future))
+	})
+}
+
+func TestNewTimer(t *testing.T) {
+	s := testcase.NewSpec(t)
+	s.HasSideEffect()
+
+	s.Test("By default, it behaves as time.NewTimer", func(t *testcase.T) {
+		duration := time.Duration(t.Random.IntB(24, 42)) * time.Millisecond
+		timer := clock.NewTimer(duration)
+		defer timer.Stop()
+		assert.NotWithin(t, duration/2, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+		assert.Within(t, duration+BufferTime, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("Stop prevents the timer from firing", func(t *testcase.T) {
+		timer := clock.NewTimer(time.Millisecond)
+		assert.True(t, timer.Stop(), "expected that the timer was active")
+		assert.False(t, timer.Stop(), "expected that the timer is already stopped")
+		assert.NotWithin(t, 50*time.Millisecond, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("Reset reactivates the timer with the new duration", func(t *testcase.T) {
+		timer := clock.NewTimer(time.Hour)
+		defer timer.Stop()
+		assert.True(t, timer.Reset(time.Millisecond), "expected that the timer was active")
+		assert.Within(t, time.Second, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+		assert.False(t, timer.Reset(time.Millisecond), "expected that the timer already fired")
+		assert.Within(t, time.Second, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("when time travel happens during waiting on the timer, then it will affect it", func(t *testcase.T) {
+		timer := clock.NewTimer(time.Hour)
+		defer timer.Stop()
+
+		timecop.Travel(t, 30*time.Minute)
+		select {
+		case <-timer.C:
+			t.Fatal("it was not expected that the timer is done since we moved less forward than the total duration")
+		default:
+		}
+
+		timecop.Travel(t, 30*time.Minute+BufferTime)
+		select {
+		case <-timer.C:
+		case <-time.After(3 * time.Second):
+			t.Fatal("timer should have fired after a travel that went more forward than the duration")
+		}
+	})
+
+	s.Test("deep freezing halts the timer until time travelling moves past its deadline", func(t *testcase.T) {
+		timecop.Travel(t, time.Duration(0), timecop.DeepFreeze)
+		timer := clock.NewTimer(time.Microsecond)
+		defer timer.Stop()
+
+		var tryRead = func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		}
+		assert.NotWithin(t, time.Millisecond, tryRead)
+		timecop.Travel(t, time.Microsecond, timecop.DeepFreeze)
+		assert.Within(t, time.Second, tryRead)
+	})
+
+	s.Test("with timecop.SetSpeed, the timer fires faster", func(t *testcase.T) {
+		timecop.SetSpeed(t, 100)
+		timer := clock.NewTimer(time.Second)
+		defer timer.Stop()
+		assert.Within(t, 100*time.Millisecond, func(ctx context.Context) {
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("with timecop.Manual, the timer fires when the virtual clock is advanced", func(t *testcase.T) {
+		vc := timecop.Manual(t)
+		start := clock.Now()
+		timer := clock.NewTimer(time.Hour)
+		vc.Advance(time.Hour - time.Nanosecond)
+		select {
+		case <-timer.C:
+			t.Fatal("timer fired before its deadline")
+		default:
+		}
+		assert.True(t, timer.Reset(2*time.Hour))
+		vc.Advance(2 * time.Hour)
+		select {
+		case at := <-timer.C:
+			assert.True(t, start.Add(3*time.Hour-time.Nanosecond).Equal(at))
+		default:
+			t.Fatal("timer didn't fire")
+		}
+		assert.False(t, timer.Stop())
+	})
+}
+
+func TestAfterFunc(t *testing.T) {
+	s := testcase.NewSpec(t)
+	s.HasSideEffect()
+
+	s.Test("By default, it behaves as time.AfterFunc", func(t *testcase.T) {
+		done := make(chan struct{})
+		timer := clock.AfterFunc(time.Millisecond, func() { close(done) })
+		assert.Nil(t, timer.C)
+		assert.Within(t, time.Second, func(ctx context.Context) {
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("Stop prevents the function call", func(t *testcase.T) {
+		var called int32
+		timer := clock.AfterFunc(10*time.Millisecond, func() { atomic.AddInt32(&called, 1) })
+		assert.True(t, timer.Stop())
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&called))
+	})
+
+	s.Test("when time travel happens during waiting, then it will affect it", func(t *testcase.T) {
+		done := make(chan struct{})
+		timer := clock.AfterFunc(time.Hour, func() { close(done) })
+		defer timer.Stop()
+		timecop.Travel(t, time.Hour+BufferTime)
+		assert.Within(t, 3*time.Second, func(ctx context.Context) {
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		})
+	})
+
+	s.Test("with timecop.Manual, the function is called when the virtual clock is advanced", func(t *testcase.T) {
+		vc := timecop.Manual(t)
+		done := make(chan struct{})
+		clock.AfterFunc(time.Hour, func() { close(done) })
+		vc.Advance(time.Hour)
+		assert.Within(t, time.Second, func(ctx context.Context) {
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		})
+	})
+}
+
+func TestWithDeadline(t *testing.T) {
+	s := testcase.NewSpec(t)
+	s.HasSideEffect()
+
+	s.Test("By default, it behaves as context.WithDeadline", func(t *testcase.T) {
+		deadline := time.Now().Add(10 * time.Millisecond)
+		ctx, cancel := clock.WithDeadline(context.Background(), deadline)
+		defer cancel()
+		got, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.True(t, deadline.Equal(got))
+		assert.NoError(t, ctx.Err())
+		assert.Within(t, time.Second, func(c context.Context) {
+			select {
+			case <-ctx.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+
+	s.Test("deadline in the past expires instantly", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(-1*time.Second))
+		defer cancel()
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+
+	s.Test("cancel function cancels the context", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(time.Hour))
+		cancel()
+		assert.ErrorIs(t, context.Canceled, ctx.Err())
+	})
+
+	s.Test("cancelling the parent cancels the context", func(t *testcase.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel := clock.WithDeadline(parent, clock.Now().Add(time.Hour))
+		defer cancel()
+		cancelParent()
+		assert.ErrorIs(t, context.Canceled, ctx.Err())
+	})
+
+	s.Test("parent's sooner deadline is kept", func(t *testcase.T) {
+		parentDeadline := clock.Now().Add(time.Minute)
+		parent, cancelParent := clock.WithDeadline(context.Background(), parentDeadline)
+		defer cancelParent()
+		ctx, cancel := clock.WithDeadline(parent, clock.Now().Add(time.Hour))
+		defer cancel()
+		got, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.True(t, parentDeadline.Equal(got))
+	})
+
+	s.Test("values of the parent are kept", func(t *testcase.T) {
+		type key struct{}
+		ctx, cancel := clock.WithDeadline(context.WithValue(context.Background(), key{}, "v"), clock.Now().Add(time.Hour))
+		defer cancel()
+		assert.Equal[any](t, "v", ctx.Value(key{}))
+	})
+
+	s.Test("time travel past the deadline expires the context", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(30*time.Minute))
+		defer cancel()
+		assert.NoError(t, ctx.Err())
+		timecop.Travel(t, time.Hour)
+		assert.Within(t, 3*time.Second, func(c context.Context) {
+			select {
+			case <-ctx.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+
+	s.Test("contexts derived from it report the deadline exceeded error", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(30*time.Minute))
+		defer cancel()
+		child, cancelChild := context.WithCancel(ctx)
+		defer cancelChild()
+		grandchild, cancelGrandchild := context.WithTimeout(child, time.Hour)
+		defer cancelGrandchild()
+		timecop.Travel(t, time.Hour)
+		assert.Within(t, 3*time.Second, func(c context.Context) {
+			select {
+			case <-grandchild.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+		assert.ErrorIs(t, context.DeadlineExceeded, child.Err())
+		assert.ErrorIs(t, context.DeadlineExceeded, grandchild.Err())
+		assert.ErrorIs(t, context.DeadlineExceeded, context.Cause(child))
+	})
+
+	s.Test("contexts derived from an expired one report the deadline exceeded error", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(-1*time.Second))
+		defer cancel()
+		child, cancelChild := context.WithCancel(ctx)
+		defer cancelChild()
+		assert.ErrorIs(t, context.DeadlineExceeded, child.Err())
+	})
+
+	s.Test("contexts derived from it are cancelled by the cancel function", func(t *testcase.T) {
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(time.Hour))
+		child, cancelChild := context.WithCancel(ctx)
+		defer cancelChild()
+		cancel()
+		assert.Within(t, time.Second, func(c context.Context) {
+			select {
+			case <-child.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.Canceled, child.Err())
+	})
+
+	s.Test("with timecop.Manual, the context expires when the virtual clock reaches the deadline", func(t *testcase.T) {
+		vc := timecop.Manual(t)
+		ctx, cancel := clock.WithDeadline(context.Background(), clock.Now().Add(time.Hour))
+		defer cancel()
+		vc.Advance(time.Hour - time.Nanosecond)
+		assert.NoError(t, ctx.Err())
+		vc.Advance(time.Nanosecond)
+		assert.Within(t, time.Second, func(c context.Context) {
+			select {
+			case <-ctx.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	s := testcase.NewSpec(t)
+	s.HasSideEffect()
+
+	s.Test("By default, it behaves as context.WithTimeout", func(t *testcase.T) {
+		ctx, cancel := clock.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Within(t, time.Second, func(c context.Context) {
+			select {
+			case <-ctx.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+
+	s.Test("time travel past the timeout expires the context", func(t *testcase.T) {
+		ctx, cancel := clock.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+		timecop.Travel(t, time.Hour)
+		assert.Within(t, 3*time.Second, func(c context.Context) {
+			select {
+			case <-ctx.Done():
+			case <-c.Done():
+			}
+		})
+		assert.ErrorIs(t, context.DeadlineExceeded, ctx.Err())
+	})
+
+	s.Test("deadline is calculated from the travelled time", func(t *testcase.T) {
+		timecop.Travel(t, time.Hour, timecop.Freeze)
+		ctx, cancel := clock.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.True(t, clock.Now().Add(time.Minute).Equal(deadline))
+	})
+}
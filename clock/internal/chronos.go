@@ -105,6 +105,9 @@ func RemainingDuration(from time.Time, nonScaledDuration time.Duration) time.Dur
 }
 
 func Now() time.Time {
+	if s, ok := lookupScheduler(); ok {
+		return s.Now().Local()
+	}
 	defer rlock()()
 	return getTime().Local()
 }
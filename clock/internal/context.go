@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+func WithDeadline(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	if cur, ok := parent.Deadline(); ok && cur.Before(deadline) {
+		// The current deadline is already sooner than the new one.
+		return context.WithCancel(parent)
+	}
+	ctx, cancel := context.WithCancelCause(parent)
+	c := &deadlineContext{Context: ctx, deadline: deadline, done: make(chan struct{})}
+	expire := func() {
+		cancel(context.DeadlineExceeded)
+		c.finish()
+	}
+	if Until(deadline) <= 0 {
+		expire()
+		return c, func() {}
+	}
+	timer := NewCallbackTimer(Until(deadline), expire)
+	go func() {
+		<-ctx.Done()
+		timer.Stop()
+		c.finish()
+	}()
+	return c, func() {
+		timer.Stop()
+		cancel(context.Canceled)
+		c.finish()
+	}
+}
+
+// deadlineContext is a context that expires by the clock,
+// thus it reacts to time travelling.
+//
+// It has its own done channel, which makes the contexts derived from it
+// to be cancelled through its Err, instead of being attached to the embedded context,
+// which would report context.Canceled to them instead of context.DeadlineExceeded.
+type deadlineContext struct {
+	context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	mutex sync.Mutex
+	err   error
+	once  sync.Once
+}
+
+func (c *deadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *deadlineContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *deadlineContext) Err() error {
+	if c.Context.Err() != nil {
+		c.finish() // the embedded context is already done, so it doesn't block
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+// finish closes the done channel after the embedded context is done.
+// The error is either context.DeadlineExceeded when the deadline expired, or the error of the embedded context.
+func (c *deadlineContext) finish() {
+	c.once.Do(func() {
+		<-c.Context.Done()
+		err := c.Context.Err()
+		if context.Cause(c.Context) == context.DeadlineExceeded {
+			err = context.DeadlineExceeded
+		}
+		c.mutex.Lock()
+		c.err = err
+		c.mutex.Unlock()
+		close(c.done)
+	})
+}
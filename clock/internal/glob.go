@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -11,6 +12,11 @@ var (
 	AfterFunc     func(d time.Duration) <-chan time.Time
 	SinceFunc     func(start time.Time) time.Duration
 	NewTickerFunc func(d time.Duration) *Ticker
+	NewTimerFunc  func(d time.Duration) *Timer
+	AfterFuncFunc func(d time.Duration, f func()) *Timer
+	UntilFunc     func(t time.Time) time.Duration
+
+	WithDeadlineFunc func(parent context.Context, d time.Time) (context.Context, context.CancelFunc)
 )
 
 func init() {
@@ -27,6 +33,10 @@ func useTimeFunctions() struct{} {
 	AfterFunc = time.After
 	NewTickerFunc = timeNewTicker
 	SinceFunc = time.Since
+	NewTimerFunc = timeNewTimer
+	AfterFuncFunc = timeAfterFunc
+	UntilFunc = time.Until
+	WithDeadlineFunc = context.WithDeadline
 	return struct{}{}
 }
 
@@ -37,4 +47,8 @@ func useClockFunctions() {
 	AfterFunc = After
 	NewTickerFunc = NewTicker
 	SinceFunc = Since
+	NewTimerFunc = NewTimer
+	AfterFuncFunc = NewCallbackTimer
+	UntilFunc = Until
+	WithDeadlineFunc = WithDeadline
 }
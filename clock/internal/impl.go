@@ -16,6 +16,9 @@ func timeNewTicker(d time.Duration) *Ticker {
 }
 
 func NewTicker(d time.Duration) *Ticker {
+	if s, ok := lookupScheduler(); ok {
+		return s.NewTicker(d)
+	}
 	ticker := NewTestTicker(d)
 	return &Ticker{
 		C:       ticker.C,
@@ -25,10 +28,17 @@ func NewTicker(d time.Duration) *Ticker {
 }
 
 func Sleep(d time.Duration) {
+	if s, ok := lookupScheduler(); ok {
+		s.Sleep(d)
+		return
+	}
 	<-After(d)
 }
 
 func After(d time.Duration) <-chan time.Time {
+	if s, ok := lookupScheduler(); ok {
+		return s.After(d)
+	}
 	startedAt := Now()
 	ch := make(chan time.Time)
 	if d == 0 {
@@ -36,41 +46,65 @@ func After(d time.Duration) <-chan time.Time {
 		return ch
 	}
 	go func() {
-		timeTravel := make(chan TimeTravelEvent)
-		defer Notify(timeTravel)()
 		defer close(ch)
-		var handleTimeTravel func(tt TimeTravelEvent) bool
-		handleTimeTravel = func(tt TimeTravelEvent) bool {
-			deadline := startedAt.Add(d)
-			if tt.When.After(deadline) || tt.When.Equal(deadline) {
-				return true
-			}
-			if tt.Deep && tt.Freeze {
-				// wait for next time travel, since during deep freeze, the flow of time is frozen
-				return handleTimeTravel(<-timeTravel)
-			}
-			return false
+		if !await(startedAt, d, nil) {
+			return
 		}
-		if tt, ok := Check(); ok && tt.Deep && tt.Freeze {
-			if handleTimeTravel(tt) {
-				return
-			}
+		ch <- Now()
+	}()
+	return ch
+}
+
+// await blocks until the duration elapses from startedAt, while it reacts to time travelling.
+// It returns false if the cancel channel is closed before the deadline.
+func await(startedAt time.Time, d time.Duration, cancel <-chan struct{}) bool {
+	timeTravel := make(chan TimeTravelEvent)
+	defer Notify(timeTravel)()
+	deadline := startedAt.Add(d)
+	var handleTimeTravel func(tt TimeTravelEvent) (_due, _cancelled bool)
+	handleTimeTravel = func(tt TimeTravelEvent) (bool, bool) {
+		if tt.When.After(deadline) || tt.When.Equal(deadline) {
+			return true, false
 		}
-		var onWait = func() (_restart bool) {
-			c, td := timeAfterWithCleanup(RemainingDuration(startedAt, d))
-			defer td()
+		if tt.Deep && tt.Freeze {
+			// wait for next time travel, since during deep freeze, the flow of time is frozen
 			select {
 			case tt := <-timeTravel:
-				return !handleTimeTravel(tt)
-			case <-c:
-				return false
+				return handleTimeTravel(tt)
+			case <-cancel:
+				return false, true
 			}
 		}
-		for onWait() {
+		return false, false
+	}
+	if tt, ok := Check(); ok && tt.Deep && tt.Freeze {
+		due, cancelled := handleTimeTravel(tt)
+		if cancelled {
+			return false
 		}
-		ch <- Now()
-	}()
-	return ch
+		if due {
+			return true
+		}
+	}
+	var onWait = func() (_restart, _ok bool) {
+		c, td := timeAfterWithCleanup(RemainingDuration(startedAt, d))
+		defer td()
+		select {
+		case tt := <-timeTravel:
+			due, cancelled := handleTimeTravel(tt)
+			return !due && !cancelled, !cancelled
+		case <-c:
+			return false, true
+		case <-cancel:
+			return false, false
+		}
+	}
+	for {
+		restart, ok := onWait()
+		if !restart {
+			return ok
+		}
+	}
 }
 
 func timeAfterWithCleanup(d time.Duration) (<-chan time.Time, func()) {
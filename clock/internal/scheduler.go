@@ -0,0 +1,296 @@
+package internal
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+var virtual struct{ Scheduler *Scheduler }
+
+// UseScheduler makes the clock functions use the virtual Scheduler instead of the wall clock.
+// The returned function restores the previous state.
+func UseScheduler(s *Scheduler) func() {
+	defer lock()()
+	og := virtual.Scheduler
+	virtual.Scheduler = s
+	return func() {
+		defer lock()()
+		virtual.Scheduler = og
+	}
+}
+
+func lookupScheduler() (*Scheduler, bool) {
+	defer rlock()()
+	return virtual.Scheduler, virtual.Scheduler != nil
+}
+
+// NewScheduler creates a virtual clock that starts from the given time.
+func NewScheduler(start time.Time) *Scheduler {
+	return &Scheduler{now: start}
+}
+
+// Scheduler is a virtual clock, where the time only moves when it is advanced explicitly.
+// Timers, sleeps and tickers registered on the Scheduler fire in deadline order,
+// without waiting on the wall clock.
+type Scheduler struct {
+	mutex  sync.Mutex
+	now    time.Time
+	seq    int
+	timers []*virtualTimer
+}
+
+type virtualTimer struct {
+	seq      int
+	deadline time.Time
+	// period is only set for tickers.
+	period time.Duration
+	C      chan time.Time
+	// fn is only set for timers made with AfterFunc.
+	fn func()
+}
+
+// Now returns the current time of the virtual clock.
+func (s *Scheduler) Now() time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.now
+}
+
+// Advance moves the virtual clock forward by the given duration,
+// and fires every timer, sleep and ticker that became due, in deadline order.
+//
+// Advance doesn't wait for the woken goroutines.
+// A timer or sleep they register afterwards is due relative to the virtual time of its registration,
+// so it only fires with a later Advance.
+// To advance step by step, wait until Pending reports the expected number of timers before each Advance.
+func (s *Scheduler) Advance(d time.Duration) {
+	s.mutex.Lock()
+	target := s.now.Add(d)
+	s.mutex.Unlock()
+	s.advanceTo(target)
+}
+
+// RunUntilIdle advances the virtual clock until there are no more pending timers or sleeps.
+// Tickers alone don't keep the Scheduler busy, but they fire as the clock moves forward.
+//
+// Like Advance, RunUntilIdle doesn't wait for the woken goroutines to register their next timer or sleep.
+// If the code under test keeps registering new timers, RunUntilIdle won't return.
+func (s *Scheduler) RunUntilIdle() {
+	for {
+		s.mutex.Lock()
+		var (
+			deadline time.Time
+			ok       bool
+		)
+		for _, t := range s.timers {
+			if t.period != 0 {
+				continue
+			}
+			if !ok || t.deadline.Before(deadline) {
+				deadline, ok = t.deadline, true
+			}
+		}
+		s.mutex.Unlock()
+		if !ok {
+			return
+		}
+		s.advanceTo(deadline)
+	}
+}
+
+// Pending returns the number of timers, sleeps and tickers that wait on the virtual clock.
+func (s *Scheduler) Pending() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.timers)
+}
+
+func (s *Scheduler) advanceTo(target time.Time) {
+	for ok := true; ok; {
+		ok = s.fireNext(target)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.now.Before(target) {
+		s.now = target
+	}
+}
+
+func (s *Scheduler) fireNext(target time.Time) bool {
+	s.mutex.Lock()
+	if len(s.timers) == 0 {
+		s.mutex.Unlock()
+		return false
+	}
+	sort.SliceStable(s.timers, func(i, j int) bool {
+		if s.timers[i].deadline.Equal(s.timers[j].deadline) {
+			return s.timers[i].seq < s.timers[j].seq
+		}
+		return s.timers[i].deadline.Before(s.timers[j].deadline)
+	})
+	next := s.timers[0]
+	if target.Before(next.deadline) {
+		s.mutex.Unlock()
+		return false
+	}
+	if s.now.Before(next.deadline) {
+		s.now = next.deadline
+	}
+	if next.period == 0 {
+		s.timers = s.timers[1:]
+	} else {
+		s.seq++
+		next.seq = s.seq
+		next.deadline = next.deadline.Add(next.period)
+	}
+	now := s.now
+	if next.fn == nil {
+		select {
+		case next.C <- now:
+		default: // like time.Ticker, slow receivers miss ticks
+		}
+	}
+	s.mutex.Unlock()
+
+	if next.fn != nil {
+		go next.fn()
+	}
+	return true
+}
+
+func (s *Scheduler) add(d, period time.Duration) *virtualTimer {
+	t := &virtualTimer{
+		period: period,
+		C:      make(chan time.Time, 1),
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.schedule(t, d)
+	return t
+}
+
+func (s *Scheduler) schedule(t *virtualTimer, d time.Duration) {
+	s.seq++
+	t.seq = s.seq
+	t.deadline = s.now.Add(d)
+	s.timers = append(s.timers, t)
+}
+
+func (s *Scheduler) remove(t *virtualTimer) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, o := range s.timers {
+		if o == t {
+			s.timers = append(s.timers[:i:i], s.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// After waits for the virtual clock to reach the duration, and then sends the current virtual time on the returned channel.
+func (s *Scheduler) After(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		ch := make(chan time.Time, 1)
+		ch <- s.Now()
+		return ch
+	}
+	return s.add(d, 0).C
+}
+
+// Sleep blocks until the virtual clock is advanced by at least the given duration.
+func (s *Scheduler) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	<-s.add(d, 0).C
+}
+
+// NewTicker creates a Ticker that ticks each time the virtual clock passes its period.
+func (s *Scheduler) NewTicker(d time.Duration) *Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	t := s.add(d, d)
+	return &Ticker{
+		C:      t.C,
+		onStop: func() { s.remove(t) },
+		onReset: func(d time.Duration) {
+			if d <= 0 {
+				panic("non-positive interval for Ticker.Reset")
+			}
+			s.remove(t)
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			t.period = d
+			s.schedule(t, d)
+		},
+	}
+}
+
+// NewTimer creates a Timer that fires once the virtual clock passes the duration.
+func (s *Scheduler) NewTimer(d time.Duration) *Timer {
+	return s.newTimer(d, nil)
+}
+
+// AfterFunc calls f in its own goroutine once the virtual clock passes the duration.
+func (s *Scheduler) AfterFunc(d time.Duration, f func()) *Timer {
+	return s.newTimer(d, f)
+}
+
+func (s *Scheduler) newTimer(d time.Duration, f func()) *Timer {
+	t := &virtualTimer{C: make(chan time.Time, 1), fn: f}
+	s.mutex.Lock()
+	s.schedule(t, d)
+	s.mutex.Unlock()
+	timer := &Timer{
+		onStop: func() bool {
+			defer t.drain()
+			return s.remove(t)
+		},
+		onReset: func(d time.Duration) bool {
+			active := s.remove(t)
+			t.drain()
+			s.mutex.Lock()
+			s.schedule(t, d)
+			s.mutex.Unlock()
+			if d <= 0 {
+				s.advanceTo(s.Now())
+			}
+			return active
+		},
+	}
+	if f == nil {
+		timer.C = t.C
+	}
+	if d <= 0 {
+		s.advanceTo(s.Now())
+	}
+	return timer
+}
+
+// Release fires all pending timers and sleeps at the current virtual time, and stops the tickers and AfterFunc timers,
+// so no goroutine remains blocked on the Scheduler.
+func (s *Scheduler) Release() {
+	s.mutex.Lock()
+	timers, now := s.timers, s.now
+	s.timers = nil
+	s.mutex.Unlock()
+	for _, t := range timers {
+		if t.period != 0 || t.fn != nil {
+			continue
+		}
+		select {
+		case t.C <- now:
+		default:
+		}
+	}
+}
+
+func (t *virtualTimer) drain() {
+	select {
+	case <-t.C:
+	default:
+	}
+}
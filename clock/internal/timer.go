@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// Timer helps enable us to switch freely between time.Timer and clock's Timer implementation.
+type Timer struct {
+	C <-chan time.Time
+
+	onStop  func() bool
+	onReset func(d time.Duration) bool
+}
+
+func (t *Timer) Stop() bool { return t.onStop() }
+
+func (t *Timer) Reset(d time.Duration) bool { return t.onReset(d) }
+
+func timeNewTimer(d time.Duration) *Timer {
+	timer := time.NewTimer(d)
+	return &Timer{
+		C:       timer.C,
+		onStop:  timer.Stop,
+		onReset: timer.Reset,
+	}
+}
+
+func timeAfterFunc(d time.Duration, f func()) *Timer {
+	timer := time.AfterFunc(d, f)
+	return &Timer{
+		onStop:  timer.Stop,
+		onReset: timer.Reset,
+	}
+}
+
+func NewTimer(d time.Duration) *Timer {
+	if s, ok := lookupScheduler(); ok {
+		return s.NewTimer(d)
+	}
+	return newTestTimer(d, nil).toTimer()
+}
+
+func NewCallbackTimer(d time.Duration, f func()) *Timer {
+	if s, ok := lookupScheduler(); ok {
+		return s.AfterFunc(d, f)
+	}
+	return newTestTimer(d, f).toTimer()
+}
+
+func Until(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+func newTestTimer(d time.Duration, f func()) *testTimer {
+	t := &testTimer{fn: f}
+	if f == nil {
+		t.c = make(chan time.Time, 1)
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.start(d)
+	return t
+}
+
+// testTimer is a time travelling aware timer.
+// Like time.Timer since Go 1.23, Stop and Reset guarantee that no stale value is received after the call.
+type testTimer struct {
+	mutex sync.Mutex
+	c     chan time.Time
+	fn    func()
+	// cancel is only set while the timer is active
+	cancel chan struct{}
+}
+
+func (t *testTimer) toTimer() *Timer {
+	return &Timer{
+		C:       t.c,
+		onStop:  t.Stop,
+		onReset: t.Reset,
+	}
+}
+
+func (t *testTimer) start(d time.Duration) {
+	var (
+		cancel    = make(chan struct{})
+		startedAt = Now()
+	)
+	t.cancel = cancel
+	go func() {
+		if !await(startedAt, d, cancel) {
+			return
+		}
+		t.mutex.Lock()
+		if t.cancel != cancel { // stopped or reset in the meantime
+			t.mutex.Unlock()
+			return
+		}
+		t.cancel = nil
+		if t.fn == nil {
+			select {
+			case t.c <- Now():
+			default:
+			}
+		}
+		t.mutex.Unlock()
+		if t.fn != nil {
+			t.fn()
+		}
+	}()
+}
+
+func (t *testTimer) Stop() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	defer t.drain()
+	if t.cancel == nil {
+		return false
+	}
+	close(t.cancel)
+	t.cancel = nil
+	return true
+}
+
+func (t *testTimer) Reset(d time.Duration) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.drain()
+	active := t.cancel != nil
+	if active {
+		close(t.cancel)
+	}
+	t.start(d)
+	return active
+}
+
+func (t *testTimer) drain() {
+	if t.c == nil {
+		return
+	}
+	select {
+	case <-t.c:
+	default:
+	}
+}
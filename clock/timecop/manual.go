@@ -0,0 +1,31 @@
+package timecop
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase/clock/internal"
+)
+
+// Manual switches the clock package into a fully virtual mode for the rest of the test.
+// In virtual mode, the time doesn't flow on its own,
+// it only moves forward when you call Scheduler.Advance or Scheduler.RunUntilIdle.
+// The due clock.After timers, clock.Sleep calls and clock.NewTicker ticks fire in deadline order,
+// without any wall-clock waiting, which makes tests of retry loops or TTL caches instant and deterministic.
+//
+// The virtual clock starts from the current clock.Now,
+// and while Manual is in use, it takes precedence over Travel and SetSpeed.
+// At the end of the test, the pending timers and sleeps are released.
+func Manual(tb testing.TB) *Scheduler {
+	tb.Helper()
+	guardAgainstParallel(tb)
+	s := internal.NewScheduler(internal.Now())
+	restore := internal.UseScheduler(s)
+	tb.Cleanup(func() {
+		restore()
+		s.Release()
+	})
+	return s
+}
+
+// Scheduler is the virtual clock of Manual.
+type Scheduler = internal.Scheduler
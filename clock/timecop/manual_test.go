@@ -0,0 +1,150 @@
+package timecop_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/clock"
+	"go.llib.dev/testcase/clock/timecop"
+)
+
+func TestManual(t *testing.T) {
+	t.Run("time doesn't flow on its own", func(t *testing.T) {
+		s := timecop.Manual(t)
+		now := clock.Now()
+		time.Sleep(time.Millisecond)
+		assert.True(t, now.Equal(clock.Now()))
+		assert.True(t, now.Equal(s.Now()))
+	})
+	t.Run("Advance moves the time forward", func(t *testing.T) {
+		s := timecop.Manual(t)
+		now := clock.Now()
+		s.Advance(time.Hour)
+		assert.Equal(t, time.Hour, clock.Since(now))
+	})
+	t.Run("After fires only when its deadline is reached", func(t *testing.T) {
+		s := timecop.Manual(t)
+		start := clock.Now()
+		ch := clock.After(time.Minute)
+		s.Advance(time.Minute - time.Nanosecond)
+		select {
+		case <-ch:
+			t.Fatal("After fired before its deadline")
+		default:
+		}
+		s.Advance(time.Hour)
+		select {
+		case at := <-ch:
+			assert.True(t, start.Add(time.Minute).Equal(at), "expected to fire at the deadline")
+		default:
+			t.Fatal("After didn't fire after its deadline")
+		}
+		assert.Equal(t, 0, s.Pending())
+	})
+	t.Run("timers fire in deadline order", func(t *testing.T) {
+		s := timecop.Manual(t)
+		start := clock.Now()
+		var chs []<-chan time.Time
+		for _, n := range []int{3, 1, 2} {
+			chs = append(chs, clock.After(time.Duration(n)*time.Second))
+		}
+		s.Advance(time.Minute)
+		assert.True(t, start.Add(3*time.Second).Equal(<-chs[0]))
+		assert.True(t, start.Add(1*time.Second).Equal(<-chs[1]))
+		assert.True(t, start.Add(2*time.Second).Equal(<-chs[2]))
+		assert.True(t, start.Add(time.Minute).Equal(clock.Now()))
+	})
+	t.Run("sleeping goroutines are woken up by each Advance that passes their deadline", func(t *testing.T) {
+		s := timecop.Manual(t)
+		var (
+			m     sync.Mutex
+			wakes []time.Time
+			done  = make(chan struct{})
+		)
+		start := clock.Now()
+		ready := make(chan struct{})
+		go func() {
+			defer close(done)
+			close(ready)
+			for i := 0; i < 3; i++ {
+				clock.Sleep(time.Second)
+				m.Lock()
+				wakes = append(wakes, clock.Now())
+				m.Unlock()
+			}
+		}()
+		<-ready
+		for i := 0; i < 3; i++ {
+			assert.Eventually(t, time.Second, func(t testing.TB) {
+				assert.Equal(t, 1, s.Pending())
+			})
+			s.Advance(time.Second)
+		}
+		<-done
+		assert.Equal(t, []time.Time{
+			start.Add(1 * time.Second),
+			start.Add(2 * time.Second),
+			start.Add(3 * time.Second),
+		}, wakes)
+	})
+	t.Run("ticker ticks each time its period passes", func(t *testing.T) {
+		s := timecop.Manual(t)
+		start := clock.Now()
+		ticker := clock.NewTicker(time.Second)
+		defer ticker.Stop()
+		for i := 1; i <= 3; i++ {
+			s.Advance(time.Second)
+			select {
+			case at := <-ticker.C:
+				assert.True(t, start.Add(time.Duration(i)*time.Second).Equal(at))
+			default:
+				t.Fatal("ticker didn't tick")
+			}
+		}
+		ticker.Reset(time.Minute)
+		s.Advance(time.Second)
+		select {
+		case <-ticker.C:
+			t.Fatal("reset ticker ticked with its old period")
+		default:
+		}
+		ticker.Stop()
+		s.Advance(time.Hour)
+		select {
+		case <-ticker.C:
+			t.Fatal("stopped ticker ticked")
+		default:
+		}
+	})
+	t.Run("RunUntilIdle fires all pending timers", func(t *testing.T) {
+		s := timecop.Manual(t)
+		start := clock.Now()
+		c1 := clock.After(time.Hour)
+		c2 := clock.After(24 * time.Hour)
+		s.RunUntilIdle()
+		assert.True(t, start.Add(time.Hour).Equal(<-c1))
+		assert.True(t, start.Add(24*time.Hour).Equal(<-c2))
+		assert.True(t, start.Add(24*time.Hour).Equal(clock.Now()))
+		assert.Equal(t, 0, s.Pending())
+	})
+	t.Run("pending sleeps are released at the end of the test", func(t *testing.T) {
+		done := make(chan struct{})
+		t.Run("", func(t *testing.T) {
+			s := timecop.Manual(t)
+			go func() {
+				defer close(done)
+				clock.Sleep(time.Hour)
+			}()
+			assert.Eventually(t, time.Second, func(t testing.TB) {
+				assert.Equal(t, 1, s.Pending())
+			})
+		})
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("sleeping goroutine was not released")
+		}
+	})
+}
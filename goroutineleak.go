@@ -0,0 +1,59 @@
+package testcase
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// goroutineLeakTimeout is how long the goroutines started during a test have to finish after the teardown.
+const goroutineLeakTimeout = time.Second
+
+// DetectGoroutineLeaks is an opt-in guard against goroutines outliving their test.
+// It takes a snapshot of the running goroutines before each test,
+// and after the teardown, it waits briefly for the goroutines started during the test to finish.
+// The test fails with the stacks of the goroutines which are still running.
+//
+// Goroutines started by other tests running in parallel can't be told apart from leaked ones,
+// thus it is best used with sequential specs.
+func DetectGoroutineLeaks() SpecOption {
+	return specOptionFunc(func(s *Spec) {
+		s.detectGoroutineLeaks = true
+	})
+}
+
+func (spec *Spec) isGoroutineLeakDetectionEnabled() bool {
+	for _, s := range spec.specsFromParent() {
+		if s.detectGoroutineLeaks {
+			return true
+		}
+	}
+	return false
+}
+
+// guardGoroutineLeaks takes a snapshot of the running goroutines,
+// and returns a function that checks for leaked goroutines after the test's teardown.
+func (spec *Spec) guardGoroutineLeaks(tb testing.TB) func() {
+	if !spec.isGoroutineLeakDetectionEnabled() {
+		return func() {}
+	}
+	snapshot := internal.SnapshotGoroutines()
+	return func() {
+		tb.Helper()
+		leaked := internal.LeakedGoroutines(snapshot, goroutineLeakTimeout)
+		if len(leaked) == 0 {
+			return
+		}
+		tb.Log(fmterror.Message{
+			Name:  "DetectGoroutineLeaks",
+			Cause: "Goroutines started during the test are still running after its teardown.",
+			Values: []fmterror.Value{
+				{Label: "leaked goroutines", Value: fmterror.Formatted(strings.Join(leaked, "\n\n"))},
+			},
+		}.String())
+		tb.Fail()
+	}
+}
@@ -0,0 +1,67 @@
+package testcase_test
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+)
+
+func TestDetectGoroutineLeaks(t *testing.T) {
+	run := func(tb testing.TB, opts []testcase.SpecOption, spec func(s *testcase.Spec)) *doubles.TB {
+		tb.Helper()
+		dtb := &doubles.TB{}
+		s := testcase.NewSpec(dtb, opts...)
+		s.Sequential()
+		spec(s)
+		s.Finish()
+		dtb.Finish()
+		return dtb
+	}
+
+	t.Run("when a goroutine started in a Let outlives the test", func(t *testing.T) {
+		stop := make(chan struct{})
+		defer close(stop)
+		spec := func(s *testcase.Spec) {
+			worker := testcase.Let(s, func(t *testcase.T) chan struct{} {
+				started := make(chan struct{})
+				go leakyWorker(started, stop)
+				<-started
+				return started
+			})
+			s.Test("", func(t *testcase.T) { worker.Get(t) })
+		}
+
+		t.Run("and leak detection is enabled, then the test fails with the stack of the goroutine", func(t *testing.T) {
+			dtb := run(t, []testcase.SpecOption{testcase.DetectGoroutineLeaks()}, spec)
+			assert.True(t, dtb.IsFailed)
+			assert.Contains(t, dtb.Logs.String(), "DetectGoroutineLeaks")
+			assert.Contains(t, dtb.Logs.String(), "leakyWorker")
+		})
+
+		t.Run("and leak detection is not enabled, then the test passes", func(t *testing.T) {
+			dtb := run(t, nil, spec)
+			assert.False(t, dtb.IsFailed)
+		})
+	})
+
+	t.Run("when the goroutines are stopped in the teardown, then the test passes", func(t *testing.T) {
+		dtb := run(t, nil, func(s *testcase.Spec) {
+			s.Context("", func(s *testcase.Spec) {
+				s.Test("", func(t *testcase.T) {
+					started, stop := make(chan struct{}), make(chan struct{})
+					go leakyWorker(started, stop)
+					<-started
+					t.Defer(func() { close(stop) })
+				})
+			}, testcase.DetectGoroutineLeaks())
+		})
+		assert.False(t, dtb.IsFailed, assert.Message(dtb.Logs.String()))
+	})
+}
+
+func leakyWorker(started, stop chan struct{}) {
+	close(started)
+	<-stop
+}
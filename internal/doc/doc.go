@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"go.llib.dev/testcase/internal"
 )
@@ -20,6 +21,18 @@ type TestingCase struct {
 	TestFailed bool
 	// TestSkipped tells if the given test was skipped
 	TestSkipped bool
+	// Tags are the tags that apply to the test.
+	Tags []string
+	// Seed is the TESTCASE_SEED value that reproduces the test execution.
+	Seed int64
+	// Duration is the time it took to execute the test, including retries.
+	Duration time.Duration
+	// Retries is the number of times the test was re-executed due to a retry strategy, such as Flaky.
+	Retries int
+	// FailureMessage holds the error, fatal and skip messages of the test in case it failed or got skipped.
+	FailureMessage string
+	// Logs holds everything the test logged in case it failed or got skipped.
+	Logs string
 }
 
 type DocumentFormat struct{}
@@ -58,6 +71,8 @@ func newNode() *node {
 type node struct {
 	Nodes       nodes
 	TestingCase TestingCase
+	// order holds the names of the sub nodes in the order they were added.
+	order []string
 }
 
 type nodes map[string]*node
@@ -74,6 +89,7 @@ func (n *node) cd(path []string) *node {
 		}
 		if _, ok := current.Nodes[part]; !ok {
 			current.Nodes[part] = newNode()
+			current.order = append(current.order, part)
 		}
 		current = current.Nodes[part]
 	}
@@ -0,0 +1,229 @@
+package doc
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reporter writes a machine-readable report about the testing cases of a spec.
+type Reporter interface {
+	Report(ctx context.Context, w io.Writer, tcs []TestingCase) error
+}
+
+// JSONReporter writes the context tree of the testing cases as JSON.
+//
+// Every context is a node with a name and its sub nodes,
+// while the test nodes also carry the test's result, tags, seed, duration, retry count, failure message and logs.
+// The duration is represented in nanoseconds.
+type JSONReporter struct {
+	// Indent is used to indent the JSON output. When left empty, the output is compact.
+	Indent string
+}
+
+type jsonNode struct {
+	Name     string        `json:"name"`
+	Result   string        `json:"result,omitempty"`
+	Tags     []string      `json:"tags,omitempty"`
+	Seed     *int64        `json:"seed,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Retries  int           `json:"retries,omitempty"`
+	Failure  string        `json:"failure,omitempty"`
+	Logs     string        `json:"logs,omitempty"`
+	Nodes    []jsonNode    `json:"nodes,omitempty"`
+}
+
+func (r JSONReporter) Report(ctx context.Context, w io.Writer, tcs []TestingCase) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	root := newNode()
+	for _, tc := range tcs {
+		root.Add(tc)
+	}
+	enc := json.NewEncoder(w)
+	if r.Indent != "" {
+		enc.SetIndent("", r.Indent)
+	}
+	return enc.Encode(r.toJSONNodes(root))
+}
+
+func (r JSONReporter) toJSONNodes(n *node) []jsonNode {
+	var out = []jsonNode{}
+	for _, name := range n.order {
+		child := n.Nodes[name]
+		jn := jsonNode{
+			Name:  name,
+			Nodes: r.toJSONNodes(child),
+		}
+		if child.isTest() {
+			tc := child.TestingCase
+			seed := tc.Seed
+			jn.Result = resultOf(tc)
+			jn.Tags = tc.Tags
+			jn.Seed = &seed
+			jn.Duration = tc.Duration
+			jn.Retries = tc.Retries
+			jn.Failure = tc.FailureMessage
+			jn.Logs = tc.Logs
+		}
+		if len(jn.Nodes) == 0 {
+			jn.Nodes = nil
+		}
+		out = append(out, jn)
+	}
+	return out
+}
+
+// JUnitReporter writes the testing cases in the JUnit XML format.
+//
+// Each top-level context becomes a test suite,
+// and the rest of the context path is used as the test case name.
+// The tags, the seed and the retry count are written as test case properties,
+// and the logs of the test are written as its system output.
+type JUnitReporter struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+func (r JUnitReporter) Report(ctx context.Context, w io.Writer, tcs []TestingCase) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var (
+		report   junitTestSuites
+		duration time.Duration
+		suites   = map[string]*junitTestSuite{}
+		suiteDur = map[string]time.Duration{}
+		order    []string
+	)
+	for _, tc := range tcs {
+		var suiteName, caseName string
+		if 0 < len(tc.ContextPath) {
+			suiteName = tc.ContextPath[0]
+			caseName = strings.Join(tc.ContextPath[1:], " ")
+		}
+		suite, ok := suites[suiteName]
+		if !ok {
+			suite = &junitTestSuite{Name: suiteName}
+			suites[suiteName] = suite
+			order = append(order, suiteName)
+		}
+		jc := junitTestCase{
+			Name:       caseName,
+			ClassName:  suiteName,
+			Time:       seconds(tc.Duration),
+			Properties: r.properties(tc),
+			SystemOut:  tc.Logs,
+		}
+		switch {
+		case tc.TestFailed:
+			jc.Failure = &junitMessage{Message: firstLine(tc.FailureMessage), Content: tc.FailureMessage}
+			suite.Failures++
+			report.Failures++
+		case tc.TestSkipped:
+			jc.Skipped = &junitMessage{Message: firstLine(tc.FailureMessage)}
+			suite.Skipped++
+			report.Skipped++
+		}
+		suite.Tests++
+		report.Tests++
+		suiteDur[suiteName] += tc.Duration
+		duration += tc.Duration
+		suite.Cases = append(suite.Cases, jc)
+	}
+	for _, name := range order {
+		suite := suites[name]
+		suite.Time = seconds(suiteDur[name])
+		report.Suites = append(report.Suites, *suite)
+	}
+	report.Time = seconds(duration)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (r JUnitReporter) properties(tc TestingCase) []junitProperty {
+	ps := []junitProperty{
+		{Name: "seed", Value: strconv.FormatInt(tc.Seed, 10)},
+		{Name: "retries", Value: strconv.Itoa(tc.Retries)},
+	}
+	if 0 < len(tc.Tags) {
+		ps = append(ps, junitProperty{Name: "tags", Value: strings.Join(tc.Tags, ",")})
+	}
+	return ps
+}
+
+func (n *node) isTest() bool {
+	return 0 < len(n.TestingCase.ContextPath)
+}
+
+func resultOf(tc TestingCase) string {
+	switch {
+	case tc.TestFailed:
+		return "fail"
+	case tc.TestSkipped:
+		return "skip"
+	default:
+		return "pass"
+	}
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); 0 <= i {
+		return strings.TrimSpace(s[:i])
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package doc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doc"
+)
+
+var reportTestingCases = []doc.TestingCase{
+	{
+		ContextPath: []string{"TestXYZ", "smoke", "testA"},
+		Tags:        []string{"db"},
+		Seed:        42,
+		Duration:    time.Second,
+	},
+	{
+		ContextPath:    []string{"TestXYZ", "smoke", "testB"},
+		TestFailed:     true,
+		Seed:           42,
+		Duration:       500 * time.Millisecond,
+		Retries:        2,
+		FailureMessage: "[Equal]\nexpected 1 but got 2",
+		Logs:           "debug\n[Equal]\nexpected 1 but got 2",
+	},
+	{
+		ContextPath:    []string{"TestXYZ", "testC"},
+		TestSkipped:    true,
+		Seed:           42,
+		FailureMessage: "not today",
+	},
+}
+
+func TestJSONReporter(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, doc.JSONReporter{}.Report(context.Background(), &buf, reportTestingCases))
+
+	exp := `[{"name":"TestXYZ","nodes":[` +
+		`{"name":"smoke","nodes":[` +
+		`{"name":"testA","result":"pass","tags":["db"],"seed":42,"duration":1000000000},` +
+		`{"name":"testB","result":"fail","seed":42,"duration":500000000,"retries":2,"failure":"[Equal]\nexpected 1 but got 2","logs":"debug\n[Equal]\nexpected 1 but got 2"}]},` +
+		`{"name":"testC","result":"skip","seed":42,"failure":"not today"}]}]` + "\n"
+	assert.Equal(t, exp, buf.String())
+
+	t.Run("with indentation", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, doc.JSONReporter{Indent: "  "}.Report(context.Background(), &buf, reportTestingCases))
+		assert.Contains(t, buf.String(), "\n  {\n    \"name\": \"TestXYZ\",\n")
+	})
+
+	t.Run("nodes keep the order of the testing cases", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, doc.JSONReporter{}.Report(context.Background(), &buf, []doc.TestingCase{
+			{ContextPath: []string{"TestXYZ", "b"}},
+			{ContextPath: []string{"TestXYZ", "c"}},
+			{ContextPath: []string{"TestXYZ", "a"}},
+		}))
+		exp := `[{"name":"TestXYZ","nodes":[` +
+			`{"name":"b","result":"pass","seed":0},` +
+			`{"name":"c","result":"pass","seed":0},` +
+			`{"name":"a","result":"pass","seed":0}]}]` + "\n"
+		assert.Equal(t, exp, buf.String())
+	})
+
+	t.Run("without testing cases", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, doc.JSONReporter{}.Report(context.Background(), &buf, nil))
+		assert.Equal(t, "[]\n", buf.String())
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, context.Canceled, doc.JSONReporter{}.Report(ctx, &bytes.Buffer{}, reportTestingCases))
+	})
+}
+
+func TestJUnitReporter(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, doc.JUnitReporter{}.Report(context.Background(), &buf, reportTestingCases))
+	assert.NoError(t, xml.Unmarshal(buf.Bytes(), new(any)))
+
+	out := buf.String()
+	assert.Contains(t, out, xml.Header)
+	assert.Contains(t, out, `<testsuites tests="3" failures="1" skipped="1" time="1.500">`)
+	assert.Contains(t, out, `<testsuite name="TestXYZ" tests="3" failures="1" skipped="1" time="1.500">`)
+	assert.Contains(t, out, `<testcase name="smoke testA" classname="TestXYZ" time="1.000">`)
+	assert.Contains(t, out, `<property name="tags" value="db"></property>`)
+	assert.Contains(t, out, `<property name="seed" value="42"></property>`)
+	assert.Contains(t, out, `<property name="retries" value="2"></property>`)
+	assert.Contains(t, out, `<failure message="[Equal]">[Equal]&#xA;expected 1 but got 2</failure>`)
+	assert.Contains(t, out, `<skipped message="not today"></skipped>`)
+	assert.Contains(t, out, `<system-out>debug&#xA;[Equal]&#xA;expected 1 but got 2</system-out>`)
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, context.Canceled, doc.JUnitReporter{}.Report(ctx, &bytes.Buffer{}, reportTestingCases))
+	})
+}
@@ -31,11 +31,55 @@ func OrderingKeys() []string {
 
 const KeyDebug = "TESTCASE_DEBUG"
 
+// KeyReport is the environment variable key that will be checked to write a machine-readable spec report.
+// The value is the report format, optionally followed by the output directory: "<format>[:<directory>]".
+// When the directory is omitted, the reports are written into the current working directory.
+//
+// Formats:
+// - json: JSON context tree
+// - junit: JUnit XML
+const KeyReport = `TESTCASE_REPORT`
+
+// KeyShard is the environment variable key that will be checked to split the tests of the specs between shards.
+// The value is the 1-based index of the current shard, and the total number of shards: "i/n".
+// Tests are assigned to a shard by the stable hash of their context path,
+// so the same test always runs on the same shard.
+//
+// example: TESTCASE_SHARD=2/3 go test ./...
+const KeyShard = `TESTCASE_SHARD`
+
+// KeyTagInclude is the environment variable key that will be checked for a comma separated list of tags,
+// to filter down the tests to the ones that has at least one of the tags.
+const KeyTagInclude = `TESTCASE_TAG_INCLUDE`
+
+// KeyTagExclude is the environment variable key that will be checked for a comma separated list of tags,
+// to exclude the tests that has any of the tags.
+const KeyTagExclude = `TESTCASE_TAG_EXCLUDE`
+
+// KeyTags is the environment variable key that will be checked for a tag expression,
+// which the tags of a test must satisfy to be executed.
+// The expression supports the "!", "&&", "||" operators and grouping with parentheses.
+//
+// example: TESTCASE_TAGS='(db && !slow) || smoke' go test ./...
+const KeyTags = `TESTCASE_TAGS`
+
+// KeyUpdateSnapshots is the environment variable key that will be checked to create or rewrite the stored snapshots
+// with the current values during assert.MatchSnapshot, instead of comparing them.
+//
+// example: TESTCASE_UPDATE_SNAPSHOTS=true go test ./...
+const KeyUpdateSnapshots = `TESTCASE_UPDATE_SNAPSHOTS`
+
 var acceptedKeys = []string{
 	KeySeed,
 	KeyOrdering,
 	KeyOrdering2,
 	KeyDebug,
+	KeyReport,
+	KeyShard,
+	KeyTagInclude,
+	KeyTagExclude,
+	KeyTags,
+	KeyUpdateSnapshots,
 }
 
 func init() { CheckEnvKeys() }
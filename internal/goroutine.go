@@ -2,10 +2,12 @@ package internal
 
 import (
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 func GoID() int64 {
@@ -41,3 +43,91 @@ func NoParallel(tb testing.TB, fatalmsg string) {
 	})
 	tb.Setenv("TESTING_FORBID_PARALLEL_EXECUTION", "-")
 }
+
+// GoroutineSnapshot holds the IDs of the goroutines which were running at the time of the snapshot.
+type GoroutineSnapshot map[int64]struct{}
+
+// SnapshotGoroutines takes a snapshot of the currently running goroutines.
+func SnapshotGoroutines() GoroutineSnapshot {
+	snapshot := make(GoroutineSnapshot)
+	for id := range goroutineStacks() {
+		snapshot[id] = struct{}{}
+	}
+	return snapshot
+}
+
+// LeakedGoroutines returns the stacks of the goroutines which started since the snapshot and are still running.
+// The goroutines are given time to finish until the timeout, since the teardown of workers is often asynchronous.
+// Goroutines of the testing package, like parallel tests, are not considered a leak.
+func LeakedGoroutines(snapshot GoroutineSnapshot, timeout time.Duration) []string {
+	var (
+		deadline = time.Now().Add(timeout)
+		leaked   []string
+	)
+	for {
+		leaked = leaked[:0]
+		for id, stack := range goroutineStacks() {
+			if _, ok := snapshot[id]; ok {
+				continue
+			}
+			if isIgnoredGoroutine(stack) {
+				continue
+			}
+			leaked = append(leaked, stack)
+		}
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	sort.Strings(leaked)
+	return leaked
+}
+
+var ignoredGoroutineFrames = []string{
+	"testing.tRunner(",
+	"testing.(*T).Run(",
+	"testing.(*B).run1(",
+	"testing.runTests(",
+	"testing.(*M).",
+	"os/signal.signal_recv(",
+	"runtime.ensureSigM(",
+}
+
+func isIgnoredGoroutine(stack string) bool {
+	for _, frame := range ignoredGoroutineFrames {
+		if strings.Contains(stack, frame) {
+			return true
+		}
+	}
+	return false
+}
+
+// goroutineStacks returns the stacks of the running goroutines, except the current one, keyed by their ID.
+func goroutineStacks() map[int64]string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+	var (
+		current = GoID()
+		stacks  = make(map[int64]string)
+	)
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		fields := strings.Fields(strings.TrimPrefix(stack, "goroutine "))
+		if len(fields) == 0 {
+			continue
+		}
+		id, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil || id == current {
+			continue
+		}
+		stacks[id] = strings.TrimSpace(stack)
+	}
+	return stacks
+}
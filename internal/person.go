@@ -14,6 +14,14 @@ func ToContactConfig(opts ...ContactOption) ContactConfig {
 
 type ContactConfig struct {
 	SexType SexType
+	Locale  ContactLocale
+}
+
+// ContactLocale is a locale code, like "hu_HU".
+type ContactLocale string
+
+func (l ContactLocale) configure(c *ContactConfig) {
+	c.Locale = l
 }
 
 type SexType int
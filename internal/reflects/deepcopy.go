@@ -0,0 +1,113 @@
+package reflects
+
+import (
+	"reflect"
+)
+
+// DeepCopy makes a deep copy of the value, including the values behind its pointers, slices, maps and interfaces.
+// Unexported struct fields are copied as well.
+// Functions, channels and unsafe pointers are shared between the original and the copy.
+func DeepCopy(v any) any {
+	if v == nil {
+		return nil
+	}
+	c := &copier{visited: make(map[visitKey]reflect.Value)}
+	return c.Copy(reflect.ValueOf(&v).Elem()).Interface()
+}
+
+type copier struct {
+	visited map[visitKey]reflect.Value
+}
+
+// visitKey identifies a copied pointer by its address and type,
+// as a pointer to the first field of a struct has the same address as the pointer to the struct.
+type visitKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+func (c *copier) Copy(rv reflect.Value) reflect.Value {
+	if !rv.IsValid() {
+		return rv
+	}
+	rv = c.addressable(rv)
+	out := reflect.New(rv.Type()).Elem()
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return out
+		}
+		key := visitKey{addr: rv.Pointer(), typ: rv.Type()}
+		if ptr, ok := c.visited[key]; ok {
+			return ptr
+		}
+		ptr := reflect.New(rv.Type().Elem())
+		c.visited[key] = ptr
+		ptr.Elem().Set(c.Copy(rv.Elem()))
+		return ptr
+
+	case reflect.Interface:
+		if rv.IsNil() {
+			return out
+		}
+		out.Set(c.Copy(rv.Elem()))
+		return out
+
+	case reflect.Slice:
+		if rv.IsNil() {
+			return out
+		}
+		slice := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Cap())
+		for i, l := 0, rv.Len(); i < l; i++ {
+			slice.Index(i).Set(c.Copy(rv.Index(i)))
+		}
+		return slice
+
+	case reflect.Array:
+		for i, l := 0, rv.Len(); i < l; i++ {
+			out.Index(i).Set(c.Copy(rv.Index(i)))
+		}
+		return out
+
+	case reflect.Map:
+		if rv.IsNil() {
+			return out
+		}
+		m := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			m.SetMapIndex(c.Copy(iter.Key()), c.Copy(iter.Value()))
+		}
+		return m
+
+	case reflect.Struct:
+		for i, n := 0, rv.NumField(); i < n; i++ {
+			field, ok := ToSettable(out.Field(i))
+			if !ok {
+				continue
+			}
+			field.Set(c.Copy(rv.Field(i)))
+		}
+		return out
+
+	default:
+		out.Set(rv)
+		return out
+	}
+}
+
+// addressable ensures that the value can be used as a source for reflect.Value#Set,
+// even if it was obtained through an unexported struct field.
+func (c *copier) addressable(rv reflect.Value) reflect.Value {
+	if rv.CanAddr() {
+		if settable, ok := ToSettable(rv); ok {
+			return settable
+		}
+	}
+	if !rv.CanInterface() {
+		return Accessible(rv)
+	}
+	tmp := reflect.New(rv.Type()).Elem()
+	tmp.Set(rv)
+	return tmp
+}
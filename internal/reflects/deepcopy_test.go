@@ -0,0 +1,89 @@
+package reflects_test
+
+import (
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/reflects"
+)
+
+type deepCopyNode struct {
+	Name     string
+	Tags     []string
+	Attrs    map[string]*int
+	Any      any
+	Next     *deepCopyNode
+	private  []int
+	private2 struct{ m map[string]int }
+	Arr      [2][]int
+	At       time.Time
+}
+
+func TestDeepCopy(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, reflects.DeepCopy(nil))
+	})
+
+	t.Run("primitives", func(t *testing.T) {
+		assert.Equal[any](t, 42, reflects.DeepCopy(42))
+		assert.Equal[any](t, "foo", reflects.DeepCopy("foo"))
+	})
+
+	t.Run("the copy is equal, but doesn't share the memory with the original", func(t *testing.T) {
+		n := 42
+		og := &deepCopyNode{
+			Name:     "foo",
+			Tags:     []string{"a", "b"},
+			Attrs:    map[string]*int{"n": &n},
+			Any:      []int{1, 2},
+			private:  []int{1, 2, 3},
+			private2: struct{ m map[string]int }{m: map[string]int{"x": 1}},
+			Arr:      [2][]int{{1}, {2}},
+			At:       time.Now(),
+		}
+		og.Next = &deepCopyNode{Name: "bar"}
+
+		cp := reflects.DeepCopy(og).(*deepCopyNode)
+		assert.Equal(t, og, cp)
+
+		og.Tags[0] = "mutated"
+		*og.Attrs["n"] = 24
+		og.Any.([]int)[0] = 42
+		og.private[0] = 42
+		og.private2.m["x"] = 42
+		og.Arr[0][0] = 42
+		og.Next.Name = "mutated"
+
+		assert.Equal(t, []string{"a", "b"}, cp.Tags)
+		assert.Equal(t, 42, *cp.Attrs["n"])
+		assert.Equal[any](t, []int{1, 2}, cp.Any)
+		assert.Equal(t, []int{1, 2, 3}, cp.private)
+		assert.Equal(t, map[string]int{"x": 1}, cp.private2.m)
+		assert.Equal(t, [2][]int{{1}, {2}}, cp.Arr)
+		assert.Equal(t, "bar", cp.Next.Name)
+		assert.NotEqual(t, og, cp)
+	})
+
+	t.Run("circular references are kept", func(t *testing.T) {
+		og := &deepCopyNode{Name: "circular"}
+		og.Next = og
+		cp := reflects.DeepCopy(og).(*deepCopyNode)
+		assert.True(t, cp == cp.Next)
+		assert.True(t, cp != og)
+	})
+
+	t.Run("interior pointers are copied with their own type", func(t *testing.T) {
+		type Inner struct{ N int }
+		type Outer struct {
+			In Inner
+			P  *Inner
+		}
+		og := &Outer{In: Inner{N: 42}}
+		og.P = &og.In
+		cp := reflects.DeepCopy(og).(*Outer)
+		assert.Equal(t, og, cp)
+		assert.True(t, cp.P != og.P)
+		assert.Equal(t, 42, cp.P.N)
+	})
+}
@@ -1,17 +1,39 @@
 package reflects
 
 import (
+	"math"
 	"reflect"
+	"time"
 
 	"go.llib.dev/testcase/internal/teardown"
 )
 
 func DeepEqual(v1, v2 any) (bool, error) {
+	return DeepEqualWith(v1, v2, EqualOptions{})
+}
+
+// EqualOptions alter how DeepEqualWith compares the values.
+type EqualOptions struct {
+	// IgnoreFields holds the struct field names that are ignored at any depth.
+	IgnoreFields map[string]struct{}
+	// IgnoreUnexported will skip the comparison of the unexported struct fields.
+	IgnoreUnexported bool
+	// UnorderedSlices compares the slices regardless of the order of their elements.
+	UnorderedSlices bool
+	// TimeApprox is the tolerated difference between two time.Time values.
+	TimeApprox time.Duration
+	// FloatEpsilon is the tolerated difference between two floating point values.
+	FloatEpsilon float64
+	// TreatNilAsEmpty makes nil slices and maps equal to their empty counterparts.
+	TreatNilAsEmpty bool
+}
+
+func DeepEqualWith(v1, v2 any, opts EqualOptions) (bool, error) {
 	if v1 == nil || v2 == nil {
 		return v1 == v2, nil
 	}
 	return reflectDeepEqual(
-		&refMem{visited: make(map[uintptr]struct{})},
+		&refMem{visited: make(map[uintptr]struct{}), opts: opts},
 		reflect.ValueOf(v1), reflect.ValueOf(v2))
 }
 
@@ -35,6 +57,9 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 	if v1.Type() != v2.Type() {
 		return false, nil
 	}
+	if eq, ok := m.tryApprox(v1, v2); ok {
+		return eq, nil
+	}
 	if eq, err, ok := tryEqualityMethods(v1, v2); ok {
 		return eq, err
 	}
@@ -52,6 +77,16 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 			v2cptr.Elem().Set(reflect.ValueOf(v2c.Interface()))
 		}
 		for i, n := 0, v1.NumField(); i < n; i++ {
+			if m.isIgnoredField(v1.Type().Field(i)) {
+				var zero = reflect.New(v1.Type().Field(i).Type).Elem()
+				if cf, ok := ToSettable(v1cptr.Elem().Field(i)); ok {
+					cf.Set(zero)
+				}
+				if cf, ok := ToSettable(v2cptr.Elem().Field(i)); ok {
+					cf.Set(zero)
+				}
+				continue
+			}
 			f1, ok := TryToMakeAccessible(v1.Field(i))
 			if !ok {
 				continue
@@ -98,7 +133,7 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 		return true, nil
 
 	case reflect.Slice:
-		if v1.IsNil() != v2.IsNil() {
+		if v1.IsNil() != v2.IsNil() && !m.opts.TreatNilAsEmpty {
 			return false, nil
 		}
 		if v1.Len() != v2.Len() {
@@ -111,6 +146,9 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 		if v1.Type().Elem().Kind() == reflect.Uint8 {
 			return string(v1.Bytes()) == string(v2.Bytes()), nil
 		}
+		if m.opts.UnorderedSlices {
+			return m.unorderedEqual(v1, v2)
+		}
 		for i := 0; i < v1.Len(); i++ {
 			if eq, err := reflectDeepEqual(m, v1.Index(i), v2.Index(i)); !eq {
 				return eq, err
@@ -125,7 +163,7 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 		return reflectDeepEqual(m, v1.Elem(), v2.Elem())
 
 	case reflect.Map:
-		if v1.IsNil() != v2.IsNil() {
+		if v1.IsNil() != v2.IsNil() && !m.opts.TreatNilAsEmpty {
 			return false, nil
 		}
 		if v1.Len() != v2.Len() {
@@ -203,7 +241,68 @@ func reflectDeepEqual(m *refMem, v1, v2 reflect.Value) (iseq bool, _ error) {
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-type refMem struct{ visited map[uintptr]struct{} }
+type refMem struct {
+	visited map[uintptr]struct{}
+	opts    EqualOptions
+}
+
+func (i *refMem) fork() *refMem {
+	visited := make(map[uintptr]struct{}, len(i.visited))
+	for k, v := range i.visited {
+		visited[k] = v
+	}
+	return &refMem{visited: visited, opts: i.opts}
+}
+
+func (i *refMem) isIgnoredField(field reflect.StructField) bool {
+	if i.opts.IgnoreUnexported && !field.IsExported() {
+		return true
+	}
+	_, ok := i.opts.IgnoreFields[field.Name]
+	return ok
+}
+
+var typeTime = reflect.TypeOf((*time.Time)(nil)).Elem()
+
+// tryApprox compares the values with the tolerances of the EqualOptions.
+func (i *refMem) tryApprox(v1, v2 reflect.Value) (isEqual bool, ok bool) {
+	if 0 < i.opts.TimeApprox && v1.Type() == typeTime {
+		t1 := Accessible(v1).Interface().(time.Time)
+		t2 := Accessible(v2).Interface().(time.Time)
+		diff := t1.Sub(t2)
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff <= i.opts.TimeApprox, true
+	}
+	if 0 < i.opts.FloatEpsilon && (v1.Kind() == reflect.Float32 || v1.Kind() == reflect.Float64) {
+		return math.Abs(v1.Float()-v2.Float()) <= i.opts.FloatEpsilon, true
+	}
+	return false, false
+}
+
+// unorderedEqual compares two slices with the same length as multisets.
+func (i *refMem) unorderedEqual(v1, v2 reflect.Value) (bool, error) {
+	matched := make([]bool, v2.Len())
+search:
+	for x := 0; x < v1.Len(); x++ {
+		for y := 0; y < v2.Len(); y++ {
+			if matched[y] {
+				continue
+			}
+			eq, err := reflectDeepEqual(i.fork(), v1.Index(x), v2.Index(y))
+			if err != nil {
+				return false, err
+			}
+			if eq {
+				matched[y] = true
+				continue search
+			}
+		}
+		return false, nil
+	}
+	return true, nil
+}
 
 func (i *refMem) TryVisit(v1, v2 reflect.Value) (ok bool) {
 	return i.tryVisit(v1) || i.tryVisit(v2)
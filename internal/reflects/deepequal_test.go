@@ -3,6 +3,7 @@ package reflects_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"go.llib.dev/testcase/internal/reflects"
 	"go.llib.dev/testcase/random"
@@ -412,3 +413,37 @@ type NotComparableStruct struct {
 	V string
 	v []string
 }
+
+func TestDeepEqualWith(t *testing.T) {
+	type T struct {
+		A  []int
+		B  float64
+		ID int
+		at time.Time
+	}
+	now := time.Now()
+	for _, tc := range []struct {
+		desc    string
+		v1, v2  any
+		opts    reflects.EqualOptions
+		isEqual bool
+	}{
+		{desc: "zero options", v1: T{A: []int{1, 2}}, v2: T{A: []int{2, 1}}, isEqual: false},
+		{desc: "unordered slices", v1: T{A: []int{1, 2}}, v2: T{A: []int{2, 1}}, opts: reflects.EqualOptions{UnorderedSlices: true}, isEqual: true},
+		{desc: "ignored fields", v1: T{ID: 1}, v2: T{ID: 2}, opts: reflects.EqualOptions{IgnoreFields: map[string]struct{}{"ID": {}}}, isEqual: true},
+		{desc: "ignored unexported", v1: T{at: now}, v2: T{}, opts: reflects.EqualOptions{IgnoreUnexported: true}, isEqual: true},
+		{desc: "time approx", v1: now, v2: now.Add(time.Millisecond), opts: reflects.EqualOptions{TimeApprox: time.Second}, isEqual: true},
+		{desc: "float epsilon", v1: T{B: 1}, v2: T{B: 1.05}, opts: reflects.EqualOptions{FloatEpsilon: 0.1}, isEqual: true},
+		{desc: "nil as empty", v1: T{}, v2: T{A: []int{}}, opts: reflects.EqualOptions{TreatNilAsEmpty: true}, isEqual: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			isEqual, err := reflects.DeepEqualWith(tc.v1, tc.v2, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if isEqual != tc.isEqual {
+				t.Fatalf("expected %v but got %v", tc.isEqual, isEqual)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package shrink
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"go.llib.dev/testcase/internal/reflects"
+)
+
+// Candidates returns a list of simpler values derived from the received value.
+// The candidates are ordered from the most aggressive simplification towards the least aggressive one,
+// so a greedy shrinker can quickly converge into a minimal counterexample.
+//
+// Each candidate has the same type as the received value.
+// Values that can't be simplified, such as a zero value, yield no candidates.
+func Candidates(v reflect.Value) []reflect.Value {
+	return visit(v, 0)
+}
+
+// maxDepth guards against recursive data structures.
+const maxDepth = 32
+
+func visit(v reflect.Value, depth int) []reflect.Value {
+	if !v.IsValid() || maxDepth < depth {
+		return nil
+	}
+	v = reflects.Accessible(v)
+	if !v.CanInterface() {
+		return nil
+	}
+	var cs []reflect.Value
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			cs = append(cs, reflect.Zero(v.Type()))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		cs = shrinkInts(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		cs = shrinkUints(v)
+	case reflect.Float32, reflect.Float64:
+		cs = shrinkFloats(v)
+	case reflect.Complex64, reflect.Complex128:
+		if v.Complex() != 0 {
+			cs = append(cs, reflect.Zero(v.Type()))
+		}
+	case reflect.String:
+		cs = shrinkStrings(v)
+	case reflect.Slice:
+		cs = shrinkSlices(v, depth)
+	case reflect.Array:
+		cs = shrinkArrays(v, depth)
+	case reflect.Map:
+		cs = shrinkMaps(v, depth)
+	case reflect.Struct:
+		cs = shrinkStructs(v, depth)
+	case reflect.Pointer:
+		cs = shrinkPointers(v, depth)
+	case reflect.Interface:
+		cs = shrinkInterfaces(v, depth)
+	}
+	return cs
+}
+
+func shrinkInts(v reflect.Value) []reflect.Value {
+	n := v.Int()
+	if n == 0 {
+		return nil
+	}
+	var ns = []int64{0, n / 2}
+	if 0 < n {
+		ns = append(ns, n-1)
+	} else {
+		ns = append(ns, -n, n+1)
+	}
+	var cs []reflect.Value
+	for _, c := range unique(n, ns) {
+		cs = append(cs, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return cs
+}
+
+func shrinkUints(v reflect.Value) []reflect.Value {
+	n := v.Uint()
+	if n == 0 {
+		return nil
+	}
+	var cs []reflect.Value
+	for _, c := range unique(n, []uint64{0, n / 2, n - 1}) {
+		cs = append(cs, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return cs
+}
+
+func shrinkFloats(v reflect.Value) []reflect.Value {
+	f := v.Float()
+	if f == 0 {
+		return nil
+	}
+	var fs = []float64{0}
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		fs = append(fs, math.Trunc(f))
+		if 1 <= math.Abs(f) {
+			fs = append(fs, math.Trunc(f/2))
+		}
+		if f < 0 {
+			fs = append(fs, -f)
+		}
+	}
+	var cs []reflect.Value
+	for _, c := range unique(f, fs) {
+		cs = append(cs, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return cs
+}
+
+func shrinkStrings(v reflect.Value) []reflect.Value {
+	rs := []rune(v.String())
+	if len(rs) == 0 {
+		return nil
+	}
+	var ss = []string{"", string(rs[:len(rs)/2]), string(rs[len(rs)/2:])}
+	for i := range rs {
+		var c []rune
+		c = append(c, rs[:i]...)
+		c = append(c, rs[i+1:]...)
+		ss = append(ss, string(c))
+	}
+	var cs []reflect.Value
+	for _, c := range unique(v.String(), ss) {
+		cs = append(cs, reflect.ValueOf(c).Convert(v.Type()))
+	}
+	return cs
+}
+
+func shrinkSlices(v reflect.Value, depth int) []reflect.Value {
+	if v.IsNil() || v.Len() == 0 {
+		return nil
+	}
+	var (
+		length = v.Len()
+		cs     = []reflect.Value{reflect.MakeSlice(v.Type(), 0, 0)}
+	)
+	if 1 < length {
+		cs = append(cs, subSlice(v, 0, length/2), subSlice(v, length/2, length))
+	}
+	for i := 0; i < length; i++ {
+		c := reflect.MakeSlice(v.Type(), 0, length-1)
+		c = reflect.AppendSlice(c, v.Slice(0, i))
+		c = reflect.AppendSlice(c, v.Slice(i+1, length))
+		cs = append(cs, c)
+	}
+	for i := 0; i < length; i++ {
+		for _, elem := range visit(v.Index(i), depth+1) {
+			c := subSlice(v, 0, length)
+			c.Index(i).Set(elem)
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func subSlice(v reflect.Value, from, till int) reflect.Value {
+	c := reflect.MakeSlice(v.Type(), 0, till-from)
+	return reflect.AppendSlice(c, v.Slice(from, till))
+}
+
+func shrinkArrays(v reflect.Value, depth int) []reflect.Value {
+	var cs []reflect.Value
+	for i := 0; i < v.Len(); i++ {
+		for _, elem := range visit(v.Index(i), depth+1) {
+			c := reflect.New(v.Type()).Elem()
+			c.Set(v)
+			c.Index(i).Set(elem)
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func shrinkMaps(v reflect.Value, depth int) []reflect.Value {
+	if v.IsNil() || v.Len() == 0 {
+		return nil
+	}
+	// map iteration order is random,
+	// but shrinking must be repeatable with the same seed.
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%#v", keys[i].Interface()) < fmt.Sprintf("%#v", keys[j].Interface())
+	})
+	var cs = []reflect.Value{reflect.MakeMap(v.Type())}
+	for _, key := range keys {
+		c := copyMap(v)
+		c.SetMapIndex(key, reflect.Value{})
+		cs = append(cs, c)
+	}
+	for _, key := range keys {
+		for _, elem := range visit(v.MapIndex(key), depth+1) {
+			c := copyMap(v)
+			c.SetMapIndex(key, elem)
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func copyMap(v reflect.Value) reflect.Value {
+	c := reflect.MakeMapWithSize(v.Type(), v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		c.SetMapIndex(iter.Key(), iter.Value())
+	}
+	return c
+}
+
+// shrinkStructs only shrinks the exported fields,
+// because unexported fields often hold invariants that only their package knows about (e.g.: time.Time).
+func shrinkStructs(v reflect.Value, depth int) []reflect.Value {
+	var cs []reflect.Value
+	for i, n := 0, v.NumField(); i < n; i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		for _, field := range visit(v.Field(i), depth+1) {
+			c := reflect.New(v.Type()).Elem()
+			c.Set(v)
+			c.Field(i).Set(field)
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+func shrinkPointers(v reflect.Value, depth int) []reflect.Value {
+	if v.IsNil() {
+		return nil
+	}
+	var cs = []reflect.Value{reflect.Zero(v.Type())}
+	for _, elem := range visit(v.Elem(), depth+1) {
+		ptr := reflect.New(v.Type().Elem())
+		ptr.Elem().Set(elem)
+		cs = append(cs, ptr)
+	}
+	return cs
+}
+
+func shrinkInterfaces(v reflect.Value, depth int) []reflect.Value {
+	if v.IsNil() {
+		return nil
+	}
+	var cs = []reflect.Value{reflect.Zero(v.Type())}
+	for _, elem := range visit(v.Elem(), depth+1) {
+		c := reflect.New(v.Type()).Elem()
+		c.Set(elem)
+		cs = append(cs, c)
+	}
+	return cs
+}
+
+func unique[T comparable](og T, vs []T) []T {
+	var (
+		out  []T
+		seen = map[T]struct{}{og: {}}
+	)
+	for _, v := range vs {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package shrink_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/shrink"
+)
+
+func candidates[T any](v T) []T {
+	var out []T
+	for _, c := range shrink.Candidates(reflect.ValueOf(v)) {
+		out = append(out, c.Interface().(T))
+	}
+	return out
+}
+
+func TestCandidates(t *testing.T) {
+	t.Run("zero values have no candidates", func(t *testing.T) {
+		assert.Empty(t, candidates(0))
+		assert.Empty(t, candidates(uint(0)))
+		assert.Empty(t, candidates(0.0))
+		assert.Empty(t, candidates(""))
+		assert.Empty(t, candidates(false))
+		assert.Empty(t, candidates([]int(nil)))
+		assert.Empty(t, candidates(map[string]int(nil)))
+		assert.Empty(t, candidates((*int)(nil)))
+	})
+	t.Run("int", func(t *testing.T) {
+		assert.Equal(t, []int{0, 21, 41}, candidates(42))
+		assert.Equal(t, []int{0, -21, 42, -41}, candidates(-42))
+		assert.Equal(t, []int8{0}, candidates(int8(1)))
+	})
+	t.Run("uint", func(t *testing.T) {
+		assert.Equal(t, []uint{0, 21, 41}, candidates(uint(42)))
+	})
+	t.Run("float", func(t *testing.T) {
+		assert.Equal(t, []float64{0, 4, 2}, candidates(4.5))
+		assert.Equal(t, []float64{0, -4, -2, 4.5}, candidates(-4.5))
+	})
+	t.Run("bool", func(t *testing.T) {
+		assert.Equal(t, []bool{false}, candidates(true))
+	})
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, []string{"", "a", "bc", "ac", "ab"}, candidates("abc"))
+	})
+	t.Run("slice", func(t *testing.T) {
+		got := candidates([]int{1, 2})
+		assert.Equal(t, []int{}, got[0])
+		assert.Contains(t, got, []int{1})
+		assert.Contains(t, got, []int{2})
+		assert.Contains(t, got, []int{0, 2})
+		assert.Contains(t, got, []int{1, 0})
+	})
+	t.Run("slice candidates don't share the backing array with the original", func(t *testing.T) {
+		og := []int{1, 2, 3}
+		for _, c := range candidates(og) {
+			if 0 < len(c) {
+				c[0] = 42
+			}
+		}
+		assert.Equal(t, []int{1, 2, 3}, og)
+	})
+	t.Run("array", func(t *testing.T) {
+		assert.Equal(t, [2]int{0, 2}, candidates([2]int{1, 2})[0])
+	})
+	t.Run("map", func(t *testing.T) {
+		got := candidates(map[string]int{"a": 1, "b": 2})
+		assert.Equal(t, map[string]int{}, got[0])
+		assert.Equal(t, map[string]int{"b": 2}, got[1])
+		assert.Equal(t, map[string]int{"a": 1}, got[2])
+		assert.Contains(t, got, map[string]int{"a": 0, "b": 2})
+	})
+	t.Run("map candidates are deterministic", func(t *testing.T) {
+		m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+		assert.Equal(t, candidates(m), candidates(m))
+	})
+	t.Run("struct", func(t *testing.T) {
+		type T struct {
+			A int
+			B string
+			c int
+		}
+		got := candidates(T{A: 1, B: "x", c: 42})
+		assert.Equal(t, []T{{B: "x", c: 42}, {A: 1, c: 42}}, got)
+	})
+	t.Run("struct with only unexported fields is not shrunk", func(t *testing.T) {
+		assert.Empty(t, candidates(time.Now()))
+	})
+	t.Run("pointer", func(t *testing.T) {
+		n := 1
+		got := candidates(&n)
+		assert.Equal(t, 2, len(got))
+		assert.Nil(t, got[0])
+		assert.Equal(t, 0, *got[1])
+	})
+	t.Run("interface", func(t *testing.T) {
+		got := candidates([]any{1})
+		assert.Contains(t, got, []any{nil})
+		assert.Contains(t, got, []any{0})
+	})
+}
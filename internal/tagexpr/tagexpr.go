@@ -0,0 +1,231 @@
+// Package tagexpr implements the boolean tag expression language used to select tests by their tags.
+//
+// The language supports tag names, the "!" negation, the "&&" and "||" operators and grouping with parentheses.
+// The "!" binds the strongest, followed by "&&", then "||".
+//
+//	(db && !slow) || smoke
+package tagexpr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed tag expression.
+type Expr interface {
+	// Match reports whether the tag set satisfies the expression.
+	Match(tags map[string]struct{}) bool
+	String() string
+}
+
+// Parse parses a tag expression.
+func Parse(expr string) (Expr, error) {
+	p := &parser{input: expr}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, p.errorf(0, "empty expression")
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tkn, ok := p.peek(); ok {
+		return nil, p.errorf(tkn.Pos, "unexpected %s", tkn)
+	}
+	return e, nil
+}
+
+type tag string
+
+func (e tag) Match(tags map[string]struct{}) bool {
+	_, ok := tags[string(e)]
+	return ok
+}
+
+func (e tag) String() string { return string(e) }
+
+type not struct{ Expr Expr }
+
+func (e not) Match(tags map[string]struct{}) bool { return !e.Expr.Match(tags) }
+
+func (e not) String() string { return "!" + e.Expr.String() }
+
+type and struct{ Left, Right Expr }
+
+func (e and) Match(tags map[string]struct{}) bool {
+	return e.Left.Match(tags) && e.Right.Match(tags)
+}
+
+func (e and) String() string { return "(" + e.Left.String() + " && " + e.Right.String() + ")" }
+
+type or struct{ Left, Right Expr }
+
+func (e or) Match(tags map[string]struct{}) bool {
+	return e.Left.Match(tags) || e.Right.Match(tags)
+}
+
+func (e or) String() string { return "(" + e.Left.String() + " || " + e.Right.String() + ")" }
+
+type tokenKind int
+
+const (
+	tokenTag tokenKind = iota
+	tokenNot
+	tokenAnd
+	tokenOr
+	tokenOpen
+	tokenClose
+)
+
+type token struct {
+	Kind  tokenKind
+	Value string
+	Pos   int
+}
+
+func (t token) String() string {
+	if t.Kind == tokenTag {
+		return fmt.Sprintf("tag %q", t.Value)
+	}
+	return fmt.Sprintf("%q", t.Value)
+}
+
+type parser struct {
+	input  string
+	tokens []token
+	index  int
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) error {
+	return fmt.Errorf("invalid tag expression %q: %s at position %d", p.input, fmt.Sprintf(format, args...), pos+1)
+}
+
+func isOperatorChar(r rune) bool {
+	return strings.ContainsRune("!&|()", r)
+}
+
+func (p *parser) tokenize() error {
+	rs := []rune(p.input)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '!':
+			p.tokens = append(p.tokens, token{Kind: tokenNot, Value: "!", Pos: i})
+			i++
+		case r == '(':
+			p.tokens = append(p.tokens, token{Kind: tokenOpen, Value: "(", Pos: i})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, token{Kind: tokenClose, Value: ")", Pos: i})
+			i++
+		case r == '&' || r == '|':
+			if i+1 == len(rs) || rs[i+1] != r {
+				return p.errorf(i, "unexpected %q, did you mean %q", string(r), string([]rune{r, r}))
+			}
+			kind := tokenAnd
+			if r == '|' {
+				kind = tokenOr
+			}
+			p.tokens = append(p.tokens, token{Kind: kind, Value: string([]rune{r, r}), Pos: i})
+			i += 2
+		default:
+			start := i
+			for i < len(rs) && !unicode.IsSpace(rs[i]) && !isOperatorChar(rs[i]) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{Kind: tokenTag, Value: string(rs[start:i]), Pos: start})
+		}
+	}
+	return nil
+}
+
+func (p *parser) peek() (token, bool) {
+	if len(p.tokens) <= p.index {
+		return token{}, false
+	}
+	return p.tokens[p.index], true
+}
+
+func (p *parser) next() (token, error) {
+	tkn, ok := p.peek()
+	if !ok {
+		return token{}, p.errorf(len([]rune(p.input)), "unexpected end of expression")
+	}
+	p.index++
+	return tkn, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tkn, ok := p.peek()
+		if !ok || tkn.Kind != tokenOr {
+			return left, nil
+		}
+		p.index++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = or{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tkn, ok := p.peek()
+		if !ok || tkn.Kind != tokenAnd {
+			return left, nil
+		}
+		p.index++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = and{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	tkn, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch tkn.Kind {
+	case tokenNot:
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not{Expr: e}, nil
+	case tokenOpen:
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, err := p.next()
+		if err != nil {
+			return nil, p.errorf(tkn.Pos, "unclosed parenthesis")
+		}
+		if closing.Kind != tokenClose {
+			return nil, p.errorf(closing.Pos, "expected \")\" but got %s", closing)
+		}
+		return e, nil
+	case tokenTag:
+		return tag(tkn.Value), nil
+	default:
+		return nil, p.errorf(tkn.Pos, "unexpected %s", tkn)
+	}
+}
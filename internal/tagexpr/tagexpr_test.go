@@ -0,0 +1,83 @@
+package tagexpr_test
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/tagexpr"
+)
+
+func tagSet(tags ...string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, tag := range tags {
+		set[tag] = struct{}{}
+	}
+	return set
+}
+
+func TestParse(t *testing.T) {
+	type TestCase struct {
+		Expr  string
+		Tags  []string
+		Match bool
+	}
+	for _, tc := range []TestCase{
+		{Expr: "db", Tags: []string{"db"}, Match: true},
+		{Expr: "db", Tags: []string{"smoke"}, Match: false},
+		{Expr: "!db", Tags: []string{"db"}, Match: false},
+		{Expr: "!db", Tags: nil, Match: true},
+		{Expr: "!!db", Tags: []string{"db"}, Match: true},
+		{Expr: "db && slow", Tags: []string{"db"}, Match: false},
+		{Expr: "db && slow", Tags: []string{"db", "slow"}, Match: true},
+		{Expr: "db || slow", Tags: []string{"slow"}, Match: true},
+		{Expr: "db || slow", Tags: nil, Match: false},
+		{Expr: "(db && !slow) || smoke", Tags: []string{"db"}, Match: true},
+		{Expr: "(db && !slow) || smoke", Tags: []string{"db", "slow"}, Match: false},
+		{Expr: "(db && !slow) || smoke", Tags: []string{"db", "slow", "smoke"}, Match: true},
+		{Expr: "a || b && c", Tags: []string{"a"}, Match: true},
+		{Expr: "a || b && c", Tags: []string{"b"}, Match: false},
+		{Expr: "(a || b) && c", Tags: []string{"a"}, Match: false},
+		{Expr: "E2E&&!wip", Tags: []string{"E2E"}, Match: true},
+		{Expr: "  e2e.http-api:v1  ", Tags: []string{"e2e.http-api:v1"}, Match: true},
+	} {
+		tc := tc
+		t.Run(tc.Expr, func(t *testing.T) {
+			expr, err := tagexpr.Parse(tc.Expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Match, expr.Match(tagSet(tc.Tags...)))
+		})
+	}
+}
+
+func TestParse_String(t *testing.T) {
+	expr, err := tagexpr.Parse("(db && !slow) || smoke")
+	assert.NoError(t, err)
+	assert.Equal(t, "((db && !slow) || smoke)", expr.String())
+}
+
+func TestParse_invalid(t *testing.T) {
+	type TestCase struct {
+		Expr string
+		Err  string
+	}
+	for _, tc := range []TestCase{
+		{Expr: "", Err: "empty expression"},
+		{Expr: "   ", Err: "empty expression"},
+		{Expr: "db &", Err: `unexpected "&", did you mean "&&" at position 4`},
+		{Expr: "db | smoke", Err: `unexpected "|", did you mean "||" at position 4`},
+		{Expr: "db &&", Err: "unexpected end of expression at position 6"},
+		{Expr: "(db && slow", Err: "unclosed parenthesis at position 1"},
+		{Expr: "db smoke", Err: `unexpected tag "smoke" at position 4`},
+		{Expr: "db)", Err: `unexpected ")" at position 3`},
+		{Expr: "&& db", Err: `unexpected "&&" at position 1`},
+		{Expr: "()", Err: `unexpected ")" at position 2`},
+	} {
+		tc := tc
+		t.Run(tc.Expr, func(t *testing.T) {
+			_, err := tagexpr.Parse(tc.Expr)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid tag expression")
+			assert.Contains(t, err.Error(), tc.Err)
+		})
+	}
+}
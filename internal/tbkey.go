@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TBKey identifies a testing.TB as a map key, even when the testing.TB implementation is not hashable.
+// A pointer testing.TB is identified by its name and address, other testing.TB values only by their name.
+//
+// The address can be reused once the testing.TB is garbage collected,
+// so keep a reference to the testing.TB as long as its key is in use.
+type TBKey struct {
+	Name string
+	Addr uintptr
+}
+
+func KeyOfTB(tb testing.TB) TBKey {
+	key := TBKey{Name: tb.Name()}
+	if rv := reflect.ValueOf(tb); rv.Kind() == reflect.Ptr {
+		key.Addr = rv.Pointer()
+	}
+	return key
+}
@@ -118,6 +118,7 @@ func letValue[V any](spec *Spec, varName VarID, value V) Var[V] {
 	}
 	return let[V](spec, varName, func(t *T) V {
 		t.Helper()
+		t.guardMutation(varName, value)
 		v := value // pass by value copy
 		return v
 	})
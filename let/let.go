@@ -150,6 +150,14 @@ func StringNC(s *testcase.Spec, length int, charset string) testcase.Var[string]
 	})
 }
 
+// StringFromRegexp defines a random string variable that matches the regular expression.
+func StringFromRegexp(s *testcase.Spec, expr string) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.StringFromRegexp(expr)
+	})
+}
+
 func HexN(s *testcase.Spec, length int) testcase.Var[string] {
 	s.H().Helper()
 	return testcase.Let(s, func(t *testcase.T) string {
@@ -234,17 +242,97 @@ func FirstName(s *testcase.Spec, opts ...internal.ContactOption) testcase.Var[st
 	})
 }
 
-func LastName(s *testcase.Spec) testcase.Var[string] {
+func LastName(s *testcase.Spec, opts ...internal.ContactOption) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.Contact(opts...).LastName
+	})
+}
+
+func Email(s *testcase.Spec, opts ...internal.ContactOption) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.Contact(opts...).Email
+	})
+}
+
+// Address defines a random postal address.
+func Address(s *testcase.Spec) testcase.Var[random.Address] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) random.Address {
+		return t.Random.Address()
+	})
+}
+
+// PhoneNumber defines a random phone number in the E.164 format.
+func PhoneNumber(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.PhoneNumber()
+	})
+}
+
+// IBAN defines a random IBAN with valid check digits.
+func IBAN(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.IBAN()
+	})
+}
+
+// CreditCardNumber defines a random credit card number with a valid Luhn check digit.
+func CreditCardNumber(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.CreditCardNumber()
+	})
+}
+
+// IPv4 defines a random IPv4 address.
+func IPv4(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.IPv4()
+	})
+}
+
+// IPv6 defines a random IPv6 address.
+func IPv6(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.IPv6()
+	})
+}
+
+// MAC defines a random MAC address.
+func MAC(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.MAC()
+	})
+}
+
+// URL defines a random https URL.
+func URL(s *testcase.Spec) testcase.Var[string] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) string {
+		return t.Random.URL()
+	})
+}
+
+// UserAgent defines a random HTTP User-Agent header value.
+func UserAgent(s *testcase.Spec) testcase.Var[string] {
 	s.H().Helper()
 	return testcase.Let(s, func(t *testcase.T) string {
-		return t.Random.Contact().LastName
+		return t.Random.UserAgent()
 	})
 }
 
-func Email(s *testcase.Spec) testcase.Var[string] {
+// CompanyName defines a random company name.
+func CompanyName(s *testcase.Spec) testcase.Var[string] {
 	s.H().Helper()
 	return testcase.Let(s, func(t *testcase.T) string {
-		return t.Random.Contact().Email
+		return t.Random.CompanyName()
 	})
 }
 
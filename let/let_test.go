@@ -2,10 +2,13 @@ package let_test
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -126,6 +129,17 @@ func Test_smoke(t *testing.T) {
 	lenHexN := rnd.IntBetween(1, 7)
 	HexN := let.HexN(s, lenHexN)
 	UUID := let.UUID(s)
+	SKU := let.StringFromRegexp(s, `^[A-Z]{3}-\d{4}$`)
+	Address := let.Address(s)
+	PhoneNumber := let.PhoneNumber(s)
+	IBAN := let.IBAN(s)
+	CreditCardNumber := let.CreditCardNumber(s)
+	IPv4 := let.IPv4(s)
+	IPv6 := let.IPv6(s)
+	MAC := let.MAC(s)
+	URL := let.URL(s)
+	UserAgent := let.UserAgent(s)
+	CompanyName := let.CompanyName(s)
 	Element := let.OneOf(s, "foo", "bar", "baz")
 	DurationBetween := let.DurationBetween(s, time.Second, time.Minute)
 	recorder := let.HTTPTestResponseRecorder(s)
@@ -167,6 +181,17 @@ func Test_smoke(t *testing.T) {
 		assert.Must(t).NotEmpty(UUID.Get(t))
 		assert.Must(t).NotEmpty(HexN.Get(t))
 		assert.Must(t).Equal(len(HexN.Get(t)), lenHexN)
+		assert.Must(t).True(regexp.MustCompile(`^[A-Z]{3}-\d{4}$`).MatchString(SKU.Get(t)))
+		assert.Must(t).NotEmpty(Address.Get(t))
+		assert.Must(t).True(strings.HasPrefix(PhoneNumber.Get(t), "+"))
+		assert.Must(t).NotEmpty(IBAN.Get(t))
+		assert.Must(t).NotEmpty(CreditCardNumber.Get(t))
+		assert.Must(t).NotNil(net.ParseIP(IPv4.Get(t)).To4())
+		assert.Must(t).NotNil(net.ParseIP(IPv6.Get(t)))
+		assert.Must(t).NotEmpty(MAC.Get(t))
+		assert.Must(t).True(strings.HasPrefix(URL.Get(t), "https://"))
+		assert.Must(t).NotEmpty(UserAgent.Get(t))
+		assert.Must(t).NotEmpty(CompanyName.Get(t))
 		assert.Must(t).NotEmpty(Element.Get(t))
 		t.Eventually(func(it *testcase.T) {
 			assert.Must(it).True(Bool.Get(testcase.ToT(&t.TB)))
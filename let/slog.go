@@ -0,0 +1,18 @@
+//go:build go1.21
+
+package let
+
+import (
+	"log/slog"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/tclog"
+)
+
+// Logger defines an *slog.Logger whose records are captured per test,
+// and printed when the test fails.
+// The captured records can be asserted with tclog.LogContains and tclog.NoLogsAbove.
+func Logger(s *testcase.Spec) testcase.Var[*slog.Logger] {
+	s.H().Helper()
+	return tclog.Let(s)
+}
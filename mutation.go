@@ -0,0 +1,56 @@
+package testcase
+
+import (
+	"go.llib.dev/testcase/internal/fmterror"
+	"go.llib.dev/testcase/internal/reflects"
+	"go.llib.dev/testcase/pp"
+)
+
+// DetectMutations is an opt-in guard against values leaking mutations between tests.
+// It takes a deep copy of the values of LetValue variables and eager loaded variables when they are initialised,
+// and after the test, it fails the test with a diff if the value was mutated.
+//
+// Mutations leaking through shared values are a common cause of order-dependent flaky tests,
+// which often only surface under a random test ordering.
+// For why eager loaded variables are guarded as well, see Var.EagerLoading.
+func DetectMutations() SpecOption {
+	return specOptionFunc(func(s *Spec) {
+		s.detectMutations = true
+	})
+}
+
+func (spec *Spec) isMutationDetectionEnabled() bool {
+	for _, s := range spec.specsFromParent() {
+		if s.detectMutations {
+			return true
+		}
+	}
+	return false
+}
+
+// guardMutation will check that the value is not mutated by the end of the test.
+func (t *T) guardMutation(varID VarID, v any) {
+	t.TB.Helper()
+	if t.spec == nil || !t.spec.isMutationDetectionEnabled() {
+		return
+	}
+	if !reflects.IsMutable(v) {
+		return
+	}
+	snapshot := reflects.DeepCopy(v)
+	t.Defer(func() {
+		t.TB.Helper()
+		if eq, err := reflects.DeepEqual(snapshot, v); err == nil && eq {
+			return
+		}
+		t.TB.Log(fmterror.Message{
+			Name:  "DetectMutations",
+			Cause: "The value of the variable was mutated during the test.",
+			Values: []fmterror.Value{
+				{Label: "variable", Value: fmterror.Formatted(varID)},
+			},
+		}.String())
+		t.TB.Logf("\n\n%s", pp.DiffFormat(snapshot, v))
+		t.TB.Fail()
+	})
+}
@@ -0,0 +1,104 @@
+package testcase_test
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+)
+
+type mutationDetectionRegistry struct {
+	Values map[string]int
+}
+
+func TestDetectMutations(t *testing.T) {
+	run := func(tb testing.TB, opts []testcase.SpecOption, spec func(s *testcase.Spec)) *doubles.TB {
+		tb.Helper()
+		dtb := &doubles.TB{}
+		s := testcase.NewSpec(dtb, opts...)
+		s.Sequential()
+		spec(s)
+		s.Finish()
+		dtb.Finish()
+		return dtb
+	}
+
+	t.Run("when a LetValue of a registered immutable type is mutated", func(t *testing.T) {
+		defer testcase.RegisterImmutableType[mutationDetectionRegistry]()()
+		spec := func(s *testcase.Spec) {
+			registry := testcase.LetValue(s, mutationDetectionRegistry{Values: map[string]int{"foo": 1}})
+			s.Test("", func(t *testcase.T) {
+				registry.Get(t).Values["foo"] = 2
+			})
+		}
+
+		t.Run("and mutation detection is enabled, then the test fails with a diff", func(t *testing.T) {
+			dtb := run(t, []testcase.SpecOption{testcase.DetectMutations()}, spec)
+			assert.True(t, dtb.IsFailed)
+			assert.Contains(t, dtb.Logs.String(), "DetectMutations")
+			assert.Contains(t, dtb.Logs.String(), "mutated")
+			assert.Contains(t, dtb.Logs.String(), `"foo": 1`)
+			assert.Contains(t, dtb.Logs.String(), `"foo": 2`)
+		})
+
+		t.Run("and mutation detection is not enabled, then the test passes", func(t *testing.T) {
+			dtb := run(t, nil, spec)
+			assert.False(t, dtb.IsFailed)
+		})
+	})
+
+	t.Run("when an eager loaded value is mutated", func(t *testing.T) {
+		shared := map[string]int{"foo": 1}
+		spec := func(s *testcase.Spec) {
+			registry := testcase.Let(s, func(t *testcase.T) map[string]int {
+				return shared
+			}).EagerLoading(s)
+
+			s.Context("", func(s *testcase.Spec) {
+				s.Test("", func(t *testcase.T) {
+					registry.Get(t)["foo"]++
+				})
+			}, testcase.DetectMutations())
+		}
+
+		dtb := run(t, nil, spec)
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "DetectMutations")
+	})
+
+	t.Run("when the value has a pointer to its own field, then it is guarded without a panic", func(t *testing.T) {
+		type Inner struct{ N int }
+		type Outer struct {
+			In Inner
+			P  *Inner
+		}
+		var ran bool
+		dtb := run(t, []testcase.SpecOption{testcase.DetectMutations()}, func(s *testcase.Spec) {
+			outer := testcase.Let(s, func(t *testcase.T) *Outer {
+				o := &Outer{In: Inner{N: 42}}
+				o.P = &o.In
+				return o
+			}).EagerLoading(s)
+			s.Test("", func(t *testcase.T) {
+				ran = true
+				assert.Equal(t, 42, outer.Get(t).P.N)
+			})
+		})
+		assert.True(t, ran)
+		assert.False(t, dtb.IsFailed)
+	})
+
+	t.Run("when the variable values are not mutated, then the test passes", func(t *testing.T) {
+		defer testcase.RegisterImmutableType[mutationDetectionRegistry]()()
+		dtb := run(t, []testcase.SpecOption{testcase.DetectMutations()}, func(s *testcase.Spec) {
+			registry := testcase.LetValue(s, mutationDetectionRegistry{Values: map[string]int{"foo": 1}})
+			list := testcase.Let(s, func(t *testcase.T) []int { return []int{1, 2, 3} }).EagerLoading(s)
+			s.Test("", func(t *testcase.T) {
+				assert.Equal(t, 1, registry.Get(t).Values["foo"])
+				list.Set(t, append(list.Get(t), 4))
+			})
+		})
+		assert.False(t, dtb.IsFailed)
+	})
+}
@@ -10,12 +10,15 @@ import (
 
 // Diff will pretty print two value and show side-by-side the difference between them.
 func Diff[T any](v1, v2 T) {
-	_, _ = defaultWriter.Write([]byte(DiffString(Format(v1), Format(v2))))
+	_, _ = defaultWriter.Write([]byte(DiffFormat(v1, v2)))
 }
 
 // DiffFormat format the values in pp.Format and compare the results line by line in a side-by-side style.
+// The DefaultFormatter's settings apply, except colouring, as it would break the alignment of the columns.
 func DiffFormat[T any](v1, v2 T) string {
-	return DiffString(Format(v1), Format(v2))
+	f := DefaultFormatter
+	f.Color = false
+	return DiffString(f.Format(v1), f.Format(v2))
 }
 
 // DiffString compare strings line by line in a side-by-side style.
@@ -0,0 +1,355 @@
+package pp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.llib.dev/testcase/internal/reflects"
+)
+
+// DiffStructural compares the two values structurally, and reports each difference with the path of the changed value.
+// Unlike DiffFormat, unchanged parts are not printed, so a single changed leaf doesn't get buried in a large payload.
+//
+//	.Orders[3].Items["sku"].Qty: 1 → 2
+//	.Tags[0] → .Tags[2]: moved "foo"
+//	.Tags[3]: added "bar"
+//
+// To use it in the assertion failure messages, set it as the assert.DiffFunc:
+//
+//	assert.DiffFunc = pp.DiffStructural[any]
+func DiffStructural[T any](v1, v2 T) string {
+	d := &structuralDiffer{}
+	d.Diff("", reflect.ValueOf(&v1).Elem(), reflect.ValueOf(&v2).Elem())
+	if len(d.changes) == 0 {
+		return DiffFormat(v1, v2)
+	}
+	var out strings.Builder
+	for _, c := range d.changes {
+		out.WriteString(c.String())
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+// maxStructuralDiffAlignment is the maximum number of element comparisons
+// used to align two slices, before falling back to index based comparison.
+const maxStructuralDiffAlignment = 1 << 16
+
+type structuralDiffer struct {
+	changes []structuralChange
+	visited map[[2]uintptr]struct{}
+}
+
+type structuralChangeKind int
+
+const (
+	structuralChanged structuralChangeKind = iota
+	structuralAdded
+	structuralRemoved
+	structuralMoved
+)
+
+type structuralChange struct {
+	Kind     structuralChangeKind
+	Path     string
+	FromPath string
+	Old, New reflect.Value
+	Redacted bool
+}
+
+func (c structuralChange) String() string {
+	if c.Redacted {
+		return fmt.Sprintf("%s: /* redacted */ changed", rootPath(c.Path))
+	}
+	switch c.Kind {
+	case structuralAdded:
+		return fmt.Sprintf("%s: added %s", rootPath(c.Path), formatValue(c.New))
+	case structuralRemoved:
+		return fmt.Sprintf("%s: removed %s", rootPath(c.Path), formatValue(c.Old))
+	case structuralMoved:
+		return fmt.Sprintf("%s → %s: moved %s", rootPath(c.FromPath), rootPath(c.Path), formatValue(c.New))
+	default:
+		return fmt.Sprintf("%s: %s → %s", rootPath(c.Path), formatValue(c.Old), formatValue(c.New))
+	}
+}
+
+func (d *structuralDiffer) Diff(path string, v1, v2 reflect.Value) {
+	if d.isEqual(v1, v2) {
+		return
+	}
+	if !v1.IsValid() || !v2.IsValid() || v1.Type() != v2.Type() {
+		d.changed(path, v1, v2)
+		return
+	}
+	v1, v2 = reflects.Accessible(v1), reflects.Accessible(v2)
+	switch v1.Kind() {
+	case reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			d.changed(path, v1, v2)
+			return
+		}
+		d.Diff(path, addressable(v1.Elem()), addressable(v2.Elem()))
+
+	case reflect.Pointer:
+		if v1.IsNil() || v2.IsNil() {
+			d.changed(path, v1, v2)
+			return
+		}
+		if !d.tryVisit(v1, v2) {
+			return
+		}
+		d.Diff(path, v1.Elem(), v2.Elem())
+
+	case reflect.Struct:
+		if v1.Type() == typeTimeTime {
+			d.changed(path, v1, v2)
+			return
+		}
+		for i, n := 0, v1.NumField(); i < n; i++ {
+			field := v1.Type().Field(i)
+			switch getFieldTag(field) {
+			case fieldTagOmit:
+				continue
+			case fieldTagRedact:
+				if !d.isEqual(v1.Field(i), v2.Field(i)) {
+					d.add(structuralChange{Kind: structuralChanged, Path: path + "." + field.Name, Redacted: true})
+				}
+			default:
+				d.Diff(path+"."+field.Name, v1.Field(i), v2.Field(i))
+			}
+		}
+
+	case reflect.Map:
+		if v1.IsNil() || v2.IsNil() {
+			d.changed(path, v1, v2)
+			return
+		}
+		d.diffMap(path, v1, v2)
+
+	case reflect.Slice:
+		if v1.IsNil() || v2.IsNil() || v1.Type().ConvertibleTo(typeByteSlice) {
+			d.changed(path, v1, v2)
+			return
+		}
+		d.diffList(path, v1, v2)
+
+	case reflect.Array:
+		d.diffList(path, v1, v2)
+
+	default:
+		d.changed(path, v1, v2)
+	}
+}
+
+func (d *structuralDiffer) diffMap(path string, v1, v2 reflect.Value) {
+	var (
+		keys  []reflect.Value
+		names = make(map[string]struct{})
+	)
+	for _, m := range []reflect.Value{v1, v2} {
+		for _, key := range m.MapKeys() {
+			name := formatValue(key)
+			if _, ok := names[name]; ok {
+				continue
+			}
+			names[name] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+	for _, key := range keys {
+		var (
+			keyPath = fmt.Sprintf("%s[%s]", path, formatValue(key))
+			e1      = v1.MapIndex(key)
+			e2      = v2.MapIndex(key)
+		)
+		switch {
+		case !e1.IsValid():
+			d.add(structuralChange{Kind: structuralAdded, Path: keyPath, New: e2})
+		case !e2.IsValid():
+			d.add(structuralChange{Kind: structuralRemoved, Path: keyPath, Old: e1})
+		default:
+			d.Diff(keyPath, addressable(e1), addressable(e2))
+		}
+	}
+}
+
+// diffList aligns the elements of the two lists by their longest common subsequence.
+// Elements that are only present in one of the lists are checked whether they moved to a new position,
+// and the remaining ones between the same aligned elements are compared with each other.
+func (d *structuralDiffer) diffList(path string, v1, v2 reflect.Value) {
+	var (
+		n, m     = v1.Len(), v2.Len()
+		elemPath = func(i int) string { return fmt.Sprintf("%s[%d]", path, i) }
+	)
+	if maxStructuralDiffAlignment < n*m {
+		for i := 0; i < n || i < m; i++ {
+			switch {
+			case m <= i:
+				d.add(structuralChange{Kind: structuralRemoved, Path: elemPath(i), Old: v1.Index(i)})
+			case n <= i:
+				d.add(structuralChange{Kind: structuralAdded, Path: elemPath(i), New: v2.Index(i)})
+			default:
+				d.Diff(elemPath(i), v1.Index(i), v2.Index(i))
+			}
+		}
+		return
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of v1[i:] and v2[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; 0 <= i; i-- {
+		for j := m - 1; 0 <= j; j-- {
+			if d.isEqual(v1.Index(i), v2.Index(j)) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// segments group the unaligned elements by the number of aligned elements before them.
+	var (
+		removed  []int
+		added    []int
+		segment1 = make(map[int]int)
+		segment2 = make(map[int]int)
+		aligned  int
+	)
+	for i, j := 0, 0; i < n || j < m; {
+		switch {
+		case i < n && j < m && d.isEqual(v1.Index(i), v2.Index(j)):
+			aligned++
+			i++
+			j++
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			removed = append(removed, i)
+			segment1[i] = aligned
+			i++
+		default:
+			added = append(added, j)
+			segment2[j] = aligned
+			j++
+		}
+	}
+
+	var moved1, moved2 = make(map[int]struct{}), make(map[int]struct{})
+	for _, i := range removed {
+		for _, j := range added {
+			if _, ok := moved2[j]; ok {
+				continue
+			}
+			if d.isEqual(v1.Index(i), v2.Index(j)) {
+				moved1[i] = struct{}{}
+				moved2[j] = struct{}{}
+				d.add(structuralChange{Kind: structuralMoved, FromPath: elemPath(i), Path: elemPath(j), New: v2.Index(j)})
+				break
+			}
+		}
+	}
+
+	var paired1, paired2 = make(map[int]struct{}), make(map[int]struct{})
+	for _, i := range removed {
+		if _, ok := moved1[i]; ok {
+			continue
+		}
+		for _, j := range added {
+			_, isMoved := moved2[j]
+			_, isPaired := paired2[j]
+			if isMoved || isPaired || segment1[i] != segment2[j] {
+				continue
+			}
+			paired1[i] = struct{}{}
+			paired2[j] = struct{}{}
+			d.Diff(elemPath(i), v1.Index(i), v2.Index(j))
+			break
+		}
+	}
+	for _, i := range removed {
+		_, isMoved := moved1[i]
+		_, isPaired := paired1[i]
+		if !isMoved && !isPaired {
+			d.add(structuralChange{Kind: structuralRemoved, Path: elemPath(i), Old: v1.Index(i)})
+		}
+	}
+	for _, j := range added {
+		_, isMoved := moved2[j]
+		_, isPaired := paired2[j]
+		if !isMoved && !isPaired {
+			d.add(structuralChange{Kind: structuralAdded, Path: elemPath(j), New: v2.Index(j)})
+		}
+	}
+}
+
+func (d *structuralDiffer) changed(path string, v1, v2 reflect.Value) {
+	d.add(structuralChange{Kind: structuralChanged, Path: path, Old: v1, New: v2})
+}
+
+func (d *structuralDiffer) add(c structuralChange) {
+	d.changes = append(d.changes, c)
+}
+
+func (d *structuralDiffer) isEqual(v1, v2 reflect.Value) bool {
+	if !v1.IsValid() || !v2.IsValid() {
+		return v1.IsValid() == v2.IsValid()
+	}
+	a1, ok1 := reflects.TryToMakeAccessible(v1)
+	a2, ok2 := reflects.TryToMakeAccessible(v2)
+	if ok1 && ok2 {
+		if isEqual, err := reflects.DeepEqual(a1.Interface(), a2.Interface()); err == nil {
+			return isEqual
+		}
+	}
+	return formatValue(v1) == formatValue(v2)
+}
+
+func (d *structuralDiffer) tryVisit(v1, v2 reflect.Value) bool {
+	if d.visited == nil {
+		d.visited = make(map[[2]uintptr]struct{})
+	}
+	key := [2]uintptr{v1.Pointer(), v2.Pointer()}
+	if _, ok := d.visited[key]; ok {
+		return false
+	}
+	d.visited[key] = struct{}{}
+	return true
+}
+
+// addressable makes a copy of the value when it is not addressable,
+// so its unexported fields can be accessed during the comparison.
+func addressable(rv reflect.Value) reflect.Value {
+	if !rv.IsValid() || rv.CanAddr() {
+		return rv
+	}
+	av, ok := reflects.TryToMakeAccessible(rv)
+	if !ok {
+		return rv
+	}
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(av)
+	return ptr.Elem()
+}
+
+func formatValue(rv reflect.Value) string {
+	buf := &bytes.Buffer{}
+	vis := &visitor{formatter: DefaultFormatter}
+	vis.Visit(buf, rv, 0)
+	return strings.ReplaceAll(buf.String(), "\n", "\n\t")
+}
+
+func rootPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
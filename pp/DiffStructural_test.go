@@ -0,0 +1,137 @@
+package pp
+
+import (
+	"strings"
+	"testing"
+)
+
+type structuralOrder struct {
+	ID    string
+	Items map[string]structuralItem
+	Tags  []string
+	note  string
+}
+
+type structuralItem struct {
+	Qty   int
+	Price *float64
+}
+
+func TestDiffStructural(t *testing.T) {
+	price := func(v float64) *float64 { return &v }
+	type Payload struct {
+		Orders []structuralOrder
+		Meta   any
+	}
+	newPayload := func() Payload {
+		return Payload{
+			Orders: []structuralOrder{
+				{ID: "1"},
+				{ID: "2"},
+				{ID: "3"},
+				{ID: "4", Items: map[string]structuralItem{"sku": {Qty: 1, Price: price(4.2)}}, Tags: []string{"a", "b", "c"}},
+			},
+			Meta: map[string]int{"version": 1},
+		}
+	}
+
+	t.Run("nested leaf change is reported with its path", func(t *testing.T) {
+		v1, v2 := newPayload(), newPayload()
+		v2.Orders[3].Items = map[string]structuralItem{"sku": {Qty: 2, Price: price(4.2)}}
+		assertEqual(t, `.Orders[3].Items["sku"].Qty: 1 → 2`+"\n", DiffStructural(v1, v2))
+	})
+
+	t.Run("change behind a pointer and an interface", func(t *testing.T) {
+		v1, v2 := newPayload(), newPayload()
+		v2.Orders[3].Items = map[string]structuralItem{"sku": {Qty: 1, Price: price(2.4)}}
+		v2.Meta = map[string]int{"version": 2}
+		assertEqual(t, ""+
+			`.Orders[3].Items["sku"].Price: 4.2 → 2.4`+"\n"+
+			`.Meta["version"]: 1 → 2`+"\n",
+			DiffStructural(v1, v2))
+	})
+
+	t.Run("unexported field", func(t *testing.T) {
+		v1, v2 := newPayload(), newPayload()
+		v2.Orders[0].note = "x"
+		assertEqual(t, `.Orders[0].note: "" → "x"`+"\n", DiffStructural(v1, v2))
+	})
+
+	t.Run("added and removed map keys", func(t *testing.T) {
+		v1 := map[string]int{"a": 1, "b": 2}
+		v2 := map[string]int{"b": 2, "c": 3}
+		assertEqual(t, ""+
+			`["a"]: removed 1`+"\n"+
+			`["c"]: added 3`+"\n",
+			DiffStructural(v1, v2))
+	})
+
+	t.Run("moved slice elements", func(t *testing.T) {
+		assertEqual(t, `[0] → [2]: moved "a"`+"\n",
+			DiffStructural([]string{"a", "b", "c"}, []string{"b", "c", "a"}))
+	})
+
+	t.Run("added and removed slice elements", func(t *testing.T) {
+		assertEqual(t, ""+
+			`[1]: removed "b"`+"\n"+
+			`[2]: added "d"`+"\n",
+			DiffStructural([]string{"a", "b", "c"}, []string{"a", "c", "d"}))
+	})
+
+	t.Run("changed slice element between unchanged ones", func(t *testing.T) {
+		v1 := []structuralItem{{Qty: 1}, {Qty: 2}, {Qty: 3}}
+		v2 := []structuralItem{{Qty: 1}, {Qty: 42}, {Qty: 3}}
+		assertEqual(t, "[1].Qty: 2 → 42\n", DiffStructural(v1, v2))
+	})
+
+	t.Run("root value", func(t *testing.T) {
+		assertEqual(t, ".: 1 → 2\n", DiffStructural(1, 2))
+		assertEqual(t, `.: 1 → "1"`+"\n", DiffStructural[any](1, "1"))
+	})
+
+	t.Run("multiline values are indented", func(t *testing.T) {
+		got := DiffStructural([]structuralItem{}, []structuralItem{{Qty: 1}})
+		assertEqual(t, "[0]: added pp.structuralItem{\n\t\tQty: 1,\n\t\tPrice: nil,\n\t}\n", got)
+	})
+
+	t.Run("large slices are compared by index", func(t *testing.T) {
+		v1 := make([]int, 1000)
+		v2 := make([]int, 1000)
+		v2[500] = 1
+		assertEqual(t, "[500]: 0 → 1\n", DiffStructural(v1, v2))
+	})
+
+	t.Run("recursive structures", func(t *testing.T) {
+		type Node struct {
+			Value int
+			Next  *Node
+		}
+		n1 := &Node{Value: 1}
+		n1.Next = n1
+		n2 := &Node{Value: 2}
+		n2.Next = n2
+		got := DiffStructural(n1, n2)
+		if !strings.HasPrefix(got, ".Value: 1 → 2\n") {
+			t.Fatalf("unexpected diff:\n%s", got)
+		}
+	})
+
+	t.Run("pp struct tags", func(t *testing.T) {
+		type Credentials struct {
+			User     string
+			Password string `pp:"redact"`
+			Session  string `pp:"-"`
+		}
+		got := DiffStructural(
+			Credentials{User: "jane", Password: "foo", Session: "a"},
+			Credentials{User: "john", Password: "bar", Session: "b"})
+		assertEqual(t, ""+
+			`.User: "jane" → "john"`+"\n"+
+			`.Password: /* redacted */ changed`+"\n",
+			got)
+	})
+
+	t.Run("when no structural difference is found, it falls back to DiffFormat", func(t *testing.T) {
+		assertEqual(t, DiffFormat(42, 42), DiffStructural(42, 42))
+	})
+}
@@ -16,7 +16,31 @@ import (
 )
 
 func Format(v any) string {
-	return formatter{}.Format(v)
+	return DefaultFormatter.Format(v)
+}
+
+// DefaultFormatter is the Formatter used by Format, and through it by the diffs and failure messages of the assert package.
+// Configuring it changes how values are printed in the whole test suite.
+//
+//	pp.DefaultFormatter = pp.Formatter{MaxDepth: 5, MaxLength: 32}
+var DefaultFormatter = Formatter{}
+
+// Formatter pretty prints Go values.
+// Its zero value prints the values fully, without any limit.
+//
+// Struct fields can be excluded from the output with the `pp:"-"` tag,
+// and their value can be hidden with the `pp:"redact"` tag.
+type Formatter struct {
+	// MaxDepth limits how deep the nested structs, maps, slices and arrays are printed.
+	// The content of values beyond the limit is elided.
+	// Zero means no limit.
+	MaxDepth int
+	// MaxLength limits how many elements of a slice, array or map are printed.
+	// The remaining elements are elided.
+	// Zero means no limit.
+	MaxLength int
+	// Color enables ANSI colouring in the output.
+	Color bool
 }
 
 var (
@@ -25,13 +49,11 @@ var (
 	typeTimeTime     = reflect.TypeOf((*time.Time)(nil)).Elem()
 )
 
-type formatter struct{}
-
-func (f formatter) Format(v any) string {
+func (f Formatter) Format(v any) string {
 	buf := &bytes.Buffer{}
 	rv := reflect.ValueOf(&v) // should allow to make everything addressable
 	rv = rv.Elem().Elem()     // ptr -> any(value) -> value
-	vis := &visitor{}
+	vis := &visitor{formatter: f}
 	vis.Visit(buf, rv, 0)
 	if vis.isStackoverflow() {
 		return fmt.Sprintf("%#v", v)
@@ -39,7 +61,35 @@ func (f formatter) Format(v any) string {
 	return buf.String()
 }
 
+// RegisterFormat registers a custom format function for the type T.
+// Values of T will be printed with it by every Formatter.
+//
+//	var _ = pp.RegisterFormat[Money](func(m Money) string {
+//		return fmt.Sprintf("Money(%d %s)", m.Amount, m.Currency)
+//	})
+func RegisterFormat[T any](fn func(v T) string) struct{} {
+	formatRegistry.Lock()
+	defer formatRegistry.Unlock()
+	formatRegistry.fns[reflect.TypeOf((*T)(nil)).Elem()] = func(rv reflect.Value) string {
+		return fn(rv.Interface().(T))
+	}
+	return struct{}{}
+}
+
+var formatRegistry = struct {
+	sync.RWMutex
+	fns map[reflect.Type]func(reflect.Value) string
+}{fns: make(map[reflect.Type]func(reflect.Value) string)}
+
+func lookupFormat(typ reflect.Type) (func(reflect.Value) string, bool) {
+	formatRegistry.RLock()
+	defer formatRegistry.RUnlock()
+	fn, ok := formatRegistry.fns[typ]
+	return fn, ok
+}
+
 type visitor struct {
+	formatter   Formatter
 	visitedInit sync.Once
 	visited     map[reflect.Value]struct{}
 	stack       int
@@ -54,20 +104,26 @@ func (v *visitor) Visit(w io.Writer, rv reflect.Value, depth int) {
 	defer td()
 
 	if rv.Kind() == reflect.Invalid {
-		_, _ = fmt.Fprint(w, "nil")
+		v.print(w, colorNil, "nil")
 		return
 	}
 
 	rv = reflects.Accessible(rv)
 
+	if v.tryRegisteredFormat(w, rv) {
+		return
+	}
+
 	if rv.Type() == typeTimeDuration {
 		d := time.Duration(rv.Int())
-		_, _ = fmt.Fprintf(w, "/* %s */ %#v", d.String(), d)
+		v.comment(w, d.String())
+		_, _ = fmt.Fprint(w, " ")
+		v.print(w, colorNumber, fmt.Sprintf("%#v", d))
 		return
 	}
 
 	if rv.Type() == typeTimeTime {
-		_, _ = fmt.Fprintf(w, "%#v", rv.Interface())
+		v.print(w, colorNumber, fmt.Sprintf("%#v", rv.Interface()))
 		return
 	}
 
@@ -76,17 +132,17 @@ func (v *visitor) Visit(w io.Writer, rv reflect.Value, depth int) {
 	}
 
 	if rv.CanInt() {
-		_, _ = fmt.Fprintf(w, "%#v", rv.Int())
+		v.print(w, colorNumber, fmt.Sprintf("%#v", rv.Int()))
 		return
 	}
 
 	if rv.CanUint() {
-		_, _ = fmt.Fprintf(w, "%d", rv.Uint())
+		v.print(w, colorNumber, fmt.Sprintf("%d", rv.Uint()))
 		return
 	}
 
 	if rv.CanFloat() {
-		_, _ = fmt.Fprintf(w, "%#v", rv.Float())
+		v.print(w, colorNumber, fmt.Sprintf("%#v", rv.Float()))
 		return
 	}
 
@@ -101,8 +157,14 @@ func (v *visitor) Visit(w io.Writer, rv reflect.Value, depth int) {
 
 		_, _ = fmt.Fprintf(w, "%s{", v.getTypeName(rv))
 		vLen := rv.Len()
+		if v.tryDepthLimit(w, depth, vLen) {
+			return
+		}
 		for i := 0; i < vLen; i++ {
 			v.newLine(w, depth+1)
+			if v.tryLengthLimit(w, i, vLen) {
+				break
+			}
 			v.Visit(w, rv.Index(i), depth+1)
 			_, _ = fmt.Fprintf(w, ",")
 		}
@@ -114,9 +176,15 @@ func (v *visitor) Visit(w io.Writer, rv reflect.Value, depth int) {
 	case reflect.Map:
 		_, _ = fmt.Fprintf(w, "%s{", v.getTypeName(rv))
 		keys := rv.MapKeys()
+		if v.tryDepthLimit(w, depth, len(keys)) {
+			return
+		}
 		v.sortMapKeys(keys)
-		for _, key := range keys {
+		for i, key := range keys {
 			v.newLine(w, depth+1)
+			if v.tryLengthLimit(w, i, len(keys)) {
+				break
+			}
 			v.Visit(w, key, depth+1) // key
 			_, _ = fmt.Fprintf(w, ": ")
 			v.Visit(w, rv.MapIndex(key), depth+1) // value
@@ -156,7 +224,10 @@ func (v *visitor) Visit(w io.Writer, rv reflect.Value, depth int) {
 		_, _ = fmt.Fprintf(w, "make(%s, %d)", rv.Type().String(), rv.Cap())
 
 	case reflect.String:
-		_, _ = fmt.Fprintf(w, "%#v", rv.String())
+		v.print(w, colorString, fmt.Sprintf("%#v", rv.String()))
+
+	case reflect.Bool:
+		v.print(w, colorNumber, fmt.Sprintf("%#v", rv.Bool()))
 
 	default:
 		v, ok := reflects.TryToMakeAccessible(rv)
@@ -238,29 +309,108 @@ func (v *visitor) tryStringer(w io.Writer, rv reflect.Value, depth int) bool {
 		return false
 	}
 
-	_, _ = fmt.Fprintf(w, "/* %s */ ", rv.Type().String())
+	v.comment(w, rv.Type().String())
+	_, _ = fmt.Fprint(w, " ")
 	v.Visit(w, rv.MethodByName("String").Call([]reflect.Value{})[0], depth)
 	return true
 }
 
 func (v *visitor) visitStructure(w io.Writer, rv reflect.Value, depth int) {
 	_, _ = fmt.Fprintf(w, "%s{", rv.Type().String())
-	fieldNum := rv.NumField()
-	for i, fNum := 0, fieldNum; i < fNum; i++ {
-		name := rv.Type().Field(i).Name
-		field := rv.FieldByName(name)
+	var fields []int
+	for i, fNum := 0, rv.NumField(); i < fNum; i++ {
+		if getFieldTag(rv.Type().Field(i)) != fieldTagOmit {
+			fields = append(fields, i)
+		}
+	}
+	if v.tryDepthLimit(w, depth, len(fields)) {
+		return
+	}
+	for _, i := range fields {
+		field := rv.Type().Field(i)
 
 		v.newLine(w, depth+1)
-		_, _ = fmt.Fprintf(w, "%s: ", name)
-		v.Visit(w, field, depth+1)
+		_, _ = fmt.Fprintf(w, "%s: ", field.Name)
+		if getFieldTag(field) == fieldTagRedact {
+			v.comment(w, "redacted")
+		} else {
+			v.Visit(w, rv.Field(i), depth+1)
+		}
 		_, _ = fmt.Fprintf(w, ",")
 	}
-	if 0 < fieldNum {
+	if 0 < len(fields) {
 		v.newLine(w, depth)
 	}
 	_, _ = fmt.Fprint(w, "}")
 }
 
+type fieldTag int
+
+const (
+	fieldTagNone fieldTag = iota
+	fieldTagOmit
+	fieldTagRedact
+)
+
+func getFieldTag(field reflect.StructField) fieldTag {
+	switch field.Tag.Get("pp") {
+	case "-":
+		return fieldTagOmit
+	case "redact":
+		return fieldTagRedact
+	default:
+		return fieldTagNone
+	}
+}
+
+// tryDepthLimit elides the content of a value with elements, when the max depth is reached.
+// The opening brace is already written, and on elision, the closing brace is written as well.
+func (v *visitor) tryDepthLimit(w io.Writer, depth int, length int) bool {
+	if v.formatter.MaxDepth <= 0 || depth < v.formatter.MaxDepth || length == 0 {
+		return false
+	}
+	v.comment(w, "...")
+	_, _ = fmt.Fprint(w, "}")
+	return true
+}
+
+// tryLengthLimit elides the remaining elements, when the max length is reached.
+func (v *visitor) tryLengthLimit(w io.Writer, index int, length int) bool {
+	if v.formatter.MaxLength <= 0 || index < v.formatter.MaxLength {
+		return false
+	}
+	v.comment(w, fmt.Sprintf("%d more", length-index))
+	return true
+}
+
+func (v *visitor) tryRegisteredFormat(w io.Writer, rv reflect.Value) bool {
+	fn, ok := lookupFormat(rv.Type())
+	if !ok || !rv.CanInterface() {
+		return false
+	}
+	_, _ = fmt.Fprint(w, fn(rv))
+	return true
+}
+
+const (
+	colorReset   = "\x1b[0m"
+	colorString  = "\x1b[32m"
+	colorNumber  = "\x1b[36m"
+	colorNil     = "\x1b[35m"
+	colorComment = "\x1b[90m"
+)
+
+func (v *visitor) print(w io.Writer, color string, str string) {
+	if v.formatter.Color {
+		str = color + str + colorReset
+	}
+	_, _ = fmt.Fprint(w, str)
+}
+
+func (v *visitor) comment(w io.Writer, str string) {
+	v.print(w, colorComment, "/* "+str+" */")
+}
+
 func (v *visitor) newLine(w io.Writer, depth int) {
 	_, _ = w.Write([]byte("\n"))
 	_, _ = w.Write([]byte(v.indent(depth)))
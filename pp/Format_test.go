@@ -642,3 +642,74 @@ func TestFormat_map(t *testing.T) {
 		})
 	}
 }
+
+type FormatterUser struct {
+	Name     string
+	Password string `pp:"redact"`
+	Session  string `pp:"-"`
+}
+
+type FormatterMoney struct {
+	Amount   int
+	Currency string
+}
+
+var _ = pp.RegisterFormat[FormatterMoney](func(m FormatterMoney) string {
+	return fmt.Sprintf("Money(%d %s)", m.Amount, m.Currency)
+})
+
+func TestFormatter(t *testing.T) {
+	t.Run("zero value prints everything", func(t *testing.T) {
+		assert.Equal(t, "[]int{\n\t1,\n\t2,\n\t3,\n}", pp.Formatter{}.Format([]int{1, 2, 3}))
+	})
+
+	t.Run("MaxLength elides the remaining elements", func(t *testing.T) {
+		f := pp.Formatter{MaxLength: 2}
+		assert.Equal(t, "[]int{\n\t1,\n\t2,\n\t/* 3 more */\n}", f.Format([]int{1, 2, 3, 4, 5}))
+		assert.Equal(t, "map[string]int{\n\t\"a\": 1,\n\t/* 1 more */\n}", pp.Formatter{MaxLength: 1}.Format(map[string]int{"a": 1, "b": 2}))
+		assert.Equal(t, "[]int{\n\t1,\n\t2,\n}", f.Format([]int{1, 2}))
+	})
+
+	t.Run("MaxDepth elides the content of the deeper values", func(t *testing.T) {
+		type Node struct {
+			Name     string
+			Children []Node
+		}
+		v := Node{Name: "root", Children: []Node{{Name: "child", Children: []Node{{Name: "grandchild"}}}}}
+		assert.Equal(t, "pp_test.Node{\n\tName: \"root\",\n\tChildren: []pp_test.Node{/* ... */},\n}", pp.Formatter{MaxDepth: 1}.Format(v))
+		assert.Equal(t, "[][]int{\n\t[]int{},\n}", pp.Formatter{MaxDepth: 1}.Format([][]int{{}}), "empty values are not elided")
+	})
+
+	t.Run("pp struct tags", func(t *testing.T) {
+		got := pp.Format(FormatterUser{Name: "Jane", Password: "s3cr3t", Session: "token"})
+		assert.Equal(t, "pp_test.FormatterUser{\n\tName: \"Jane\",\n\tPassword: /* redacted */,\n}", got)
+	})
+
+	t.Run("registered format", func(t *testing.T) {
+		assert.Equal(t, "Money(42 EUR)", pp.Format(FormatterMoney{Amount: 42, Currency: "EUR"}))
+		assert.Equal(t, "[]pp_test.FormatterMoney{\n\tMoney(1 HUF),\n}", pp.Format([]FormatterMoney{{Amount: 1, Currency: "HUF"}}))
+	})
+
+	t.Run("Color", func(t *testing.T) {
+		got := pp.Formatter{Color: true}.Format(struct {
+			S string
+			I int
+			P *int
+		}{S: "foo", I: 42})
+		assert.Contains(t, got, "\x1b[32m\"foo\"\x1b[0m")
+		assert.Contains(t, got, "\x1b[36m42\x1b[0m")
+		assert.Contains(t, got, "\x1b[35mnil\x1b[0m")
+		assert.NotContains(t, pp.Format("foo"), "\x1b[")
+	})
+
+	t.Run("DefaultFormatter is used by Format and DiffFormat, without colouring the diff", func(t *testing.T) {
+		og := pp.DefaultFormatter
+		defer func() { pp.DefaultFormatter = og }()
+		pp.DefaultFormatter = pp.Formatter{MaxLength: 1, Color: true}
+
+		assert.Contains(t, pp.Format([]int{1, 2}), "/* 1 more */")
+		diff := pp.DiffFormat([]int{1, 2}, []int{1, 3})
+		assert.Contains(t, diff, "/* 1 more */")
+		assert.NotContains(t, diff, "\x1b[")
+	})
+}
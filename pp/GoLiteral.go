@@ -0,0 +1,336 @@
+package pp
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"go.llib.dev/testcase/internal/reflects"
+)
+
+// GoLiteral formats the value as a gofmt-ed Go expression,
+// which can be pasted into a test as it is, for example, as a regression test case.
+//
+// Types are qualified with their package name, pointers are represented with &T{},
+// time.Time values with time.Date(...), and time.Duration values as multiples of their largest unit.
+// Unexported struct fields are left out, as they can't be set outside of their package,
+// and zero value fields are omitted.
+//
+//	pp.GoLiteral(&User{Name: "Jane", CreatedAt: createdAt})
+//	// &mydomain.User{
+//	//	Name:      "Jane",
+//	//	CreatedAt: time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC),
+//	// }
+func GoLiteral(v any) string {
+	lw := &literalWriter{visited: make(map[uintptr]struct{})}
+	lw.Write(reflect.ValueOf(&v).Elem(), false)
+	src := lw.buf.Bytes()
+	if formatted, err := format.Source(src); err == nil {
+		return string(formatted)
+	}
+	return string(src)
+}
+
+type literalWriter struct {
+	buf     bytes.Buffer
+	visited map[uintptr]struct{}
+}
+
+// Write writes the value as a Go expression.
+// When typed is true, the expression is used in a context where its type is already known,
+// like a struct field or a slice element, so untyped constants don't need an explicit conversion.
+func (lw *literalWriter) Write(rv reflect.Value, typed bool) {
+	if !rv.IsValid() {
+		lw.print("nil")
+		return
+	}
+
+	rv = reflects.Accessible(rv)
+
+	switch rv.Type() {
+	case typeTimeTime:
+		lw.writeTime(rv.Interface().(time.Time))
+		return
+	case typeTimeDuration:
+		lw.writeDuration(time.Duration(rv.Int()))
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			lw.print("nil")
+			return
+		}
+		lw.Write(rv.Elem(), false)
+
+	case reflect.Bool:
+		lw.writeConst(rv, strconv.FormatBool(rv.Bool()), typed)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lw.writeConst(rv, strconv.FormatInt(rv.Int(), 10), typed)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lw.writeConst(rv, strconv.FormatUint(rv.Uint(), 10), typed)
+
+	case reflect.Float32, reflect.Float64:
+		lw.writeFloat(rv, typed)
+
+	case reflect.Complex64, reflect.Complex128:
+		c := rv.Complex()
+		bitSize := rv.Type().Bits() / 2
+		lw.print("%s(complex(%s, %s))", lw.typeName(rv.Type()), formatFloat(real(c), bitSize), formatFloat(imag(c), bitSize))
+
+	case reflect.String:
+		lw.writeConst(rv, strconv.Quote(rv.String()), typed)
+
+	case reflect.Pointer:
+		lw.writePointer(rv, typed)
+
+	case reflect.Slice:
+		if rv.IsNil() {
+			lw.writeNil(rv, typed)
+			return
+		}
+		if lw.tryByteSlice(rv) {
+			return
+		}
+		lw.writeList(rv)
+
+	case reflect.Array:
+		lw.writeList(rv)
+
+	case reflect.Map:
+		if rv.IsNil() {
+			lw.writeNil(rv, typed)
+			return
+		}
+		lw.writeMap(rv)
+
+	case reflect.Struct:
+		lw.writeStruct(rv)
+
+	case reflect.Chan:
+		if rv.IsNil() {
+			lw.writeNil(rv, typed)
+			return
+		}
+		lw.print("make(%s, %d)", lw.typeName(rv.Type()), rv.Cap())
+
+	default: // func, unsafe.Pointer
+		lw.print("nil /* %s */", lw.typeName(rv.Type()))
+	}
+}
+
+func (lw *literalWriter) print(format string, args ...any) {
+	_, _ = fmt.Fprintf(&lw.buf, format, args...)
+}
+
+func (lw *literalWriter) typeName(typ reflect.Type) string {
+	if typ == typeByteSlice {
+		return "[]byte"
+	}
+	return typ.String()
+}
+
+// writeConst writes a constant, which is converted to its type when the type is not implied by the context,
+// or by the constant's default type.
+func (lw *literalWriter) writeConst(rv reflect.Value, lit string, typed bool) {
+	if typed || rv.Type() == defaultConstType(rv.Kind()) {
+		lw.print("%s", lit)
+		return
+	}
+	lw.print("%s(%s)", lw.typeName(rv.Type()), lit)
+}
+
+func defaultConstType(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Bool:
+		return reflect.TypeOf(false)
+	case reflect.Int:
+		return reflect.TypeOf(int(0))
+	case reflect.Float64:
+		return reflect.TypeOf(float64(0))
+	case reflect.String:
+		return reflect.TypeOf("")
+	default:
+		return nil
+	}
+}
+
+func (lw *literalWriter) writeFloat(rv reflect.Value, typed bool) {
+	f := rv.Float()
+	switch {
+	case math.IsNaN(f):
+		lw.print("%s(math.NaN())", lw.typeName(rv.Type()))
+	case math.IsInf(f, 1):
+		lw.print("%s(math.Inf(1))", lw.typeName(rv.Type()))
+	case math.IsInf(f, -1):
+		lw.print("%s(math.Inf(-1))", lw.typeName(rv.Type()))
+	default:
+		lit := formatFloat(f, rv.Type().Bits())
+		if !typed && rv.Type() == defaultConstType(reflect.Float64) && !bytes.ContainsAny([]byte(lit), ".eE") {
+			lit += ".0" // keep the default type float64 instead of int
+		}
+		lw.writeConst(rv, lit, typed)
+	}
+}
+
+func formatFloat(f float64, bitSize int) string {
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+func (lw *literalWriter) writeNil(rv reflect.Value, typed bool) {
+	if typed {
+		lw.print("nil")
+		return
+	}
+	lw.print("(%s)(nil)", lw.typeName(rv.Type()))
+}
+
+func (lw *literalWriter) writePointer(rv reflect.Value, typed bool) {
+	if rv.IsNil() {
+		lw.writeNil(rv, typed)
+		return
+	}
+	if _, ok := lw.visited[rv.Pointer()]; ok {
+		lw.print("nil /* recursion */")
+		return
+	}
+	lw.visited[rv.Pointer()] = struct{}{}
+	defer delete(lw.visited, rv.Pointer())
+
+	switch rv.Elem().Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		if rv.Elem().Type() != typeTimeTime {
+			lw.print("&")
+			lw.Write(rv.Elem(), false)
+			return
+		}
+	}
+	// values that have no composite literal form need a helper function to take their address
+	lw.print("func() %s { v := ", lw.typeName(rv.Type()))
+	lw.Write(rv.Elem(), false)
+	lw.print("; return &v }()")
+}
+
+func (lw *literalWriter) tryByteSlice(rv reflect.Value) bool {
+	if !rv.Type().ConvertibleTo(typeByteSlice) {
+		return false
+	}
+	data := rv.Convert(typeByteSlice).Bytes()
+	if !utf8.Valid(data) {
+		return false
+	}
+	lw.print("%s(%s)", lw.typeName(rv.Type()), strconv.Quote(string(data)))
+	return true
+}
+
+func (lw *literalWriter) writeList(rv reflect.Value) {
+	lw.print("%s{", lw.typeName(rv.Type()))
+	if rv.Len() == 0 {
+		lw.print("}")
+		return
+	}
+	typed := rv.Type().Elem().Kind() != reflect.Interface
+	lw.print("\n")
+	for i, l := 0, rv.Len(); i < l; i++ {
+		lw.Write(rv.Index(i), typed)
+		lw.print(",\n")
+	}
+	lw.print("}")
+}
+
+func (lw *literalWriter) writeMap(rv reflect.Value) {
+	lw.print("%s{", lw.typeName(rv.Type()))
+	keys := rv.MapKeys()
+	if len(keys) == 0 {
+		lw.print("}")
+		return
+	}
+	(&visitor{}).sortMapKeys(keys)
+	var (
+		typedKey   = rv.Type().Key().Kind() != reflect.Interface
+		typedValue = rv.Type().Elem().Kind() != reflect.Interface
+	)
+	lw.print("\n")
+	for _, key := range keys {
+		lw.Write(key, typedKey)
+		lw.print(": ")
+		lw.Write(rv.MapIndex(key), typedValue)
+		lw.print(",\n")
+	}
+	lw.print("}")
+}
+
+func (lw *literalWriter) writeStruct(rv reflect.Value) {
+	lw.print("%s{", lw.typeName(rv.Type()))
+	var fields []int
+	for i, n := 0, rv.NumField(); i < n; i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() || rv.Field(i).IsZero() {
+			continue
+		}
+		fields = append(fields, i)
+	}
+	if len(fields) == 0 {
+		lw.print("}")
+		return
+	}
+	lw.print("\n")
+	for _, i := range fields {
+		field := rv.Type().Field(i)
+		lw.print("%s: ", field.Name)
+		lw.Write(rv.Field(i), field.Type.Kind() != reflect.Interface)
+		lw.print(",\n")
+	}
+	lw.print("}")
+}
+
+func (lw *literalWriter) writeTime(t time.Time) {
+	lw.print("time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month().String(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+		locationLiteral(t))
+}
+
+func locationLiteral(t time.Time) string {
+	switch t.Location() {
+	case time.UTC:
+		return "time.UTC"
+	case time.Local:
+		return "time.Local"
+	}
+	name, offset := t.Zone()
+	return fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+}
+
+var durationUnits = []struct {
+	Unit time.Duration
+	Name string
+}{
+	{Unit: time.Hour, Name: "time.Hour"},
+	{Unit: time.Minute, Name: "time.Minute"},
+	{Unit: time.Second, Name: "time.Second"},
+	{Unit: time.Millisecond, Name: "time.Millisecond"},
+	{Unit: time.Microsecond, Name: "time.Microsecond"},
+}
+
+func (lw *literalWriter) writeDuration(d time.Duration) {
+	if d == 0 {
+		lw.print("time.Duration(0)")
+		return
+	}
+	for _, u := range durationUnits {
+		if d%u.Unit == 0 {
+			lw.print("%d * %s", d/u.Unit, u.Name)
+			return
+		}
+	}
+	lw.print("%d * time.Nanosecond", d)
+}
@@ -0,0 +1,115 @@
+package pp_test
+
+import (
+	"go/parser"
+	"math"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/pp"
+)
+
+type GoLiteralAddress struct {
+	City string
+	Zip  *int
+}
+
+type GoLiteralStatus int
+
+type GoLiteralUser struct {
+	Name      string
+	Age       int
+	Status    GoLiteralStatus
+	Tags      []string
+	Attrs     map[string]any
+	Address   *GoLiteralAddress
+	CreatedAt time.Time
+	Timeout   time.Duration
+	Data      []byte
+	secret    string
+}
+
+func TestGoLiteral(t *testing.T) {
+	zip := 1234
+	createdAt := time.Date(2024, time.January, 2, 15, 4, 5, 6, time.UTC)
+
+	for _, tc := range []struct {
+		Desc string
+		V    any
+		Exp  string
+	}{
+		{Desc: "nil", V: nil, Exp: "nil"},
+		{Desc: "int", V: 42, Exp: "42"},
+		{Desc: "int64", V: int64(42), Exp: "int64(42)"},
+		{Desc: "float64", V: 42.0, Exp: "42.0"},
+		{Desc: "float32", V: float32(4.2), Exp: "float32(4.2)"},
+		{Desc: "NaN", V: math.NaN(), Exp: "float64(math.NaN())"},
+		{Desc: "string", V: "foo\n\"bar\"", Exp: `"foo\n\"bar\""`},
+		{Desc: "bool", V: true, Exp: "true"},
+		{Desc: "complex", V: complex(1, 2), Exp: "complex128(complex(1, 2))"},
+		{Desc: "named type", V: GoLiteralStatus(2), Exp: "pp_test.GoLiteralStatus(2)"},
+		{Desc: "nil slice", V: []int(nil), Exp: "([]int)(nil)"},
+		{Desc: "empty slice", V: []int{}, Exp: "[]int{}"},
+		{Desc: "slice of int64", V: []int64{1, 2}, Exp: "[]int64{\n\t1,\n\t2,\n}"},
+		{Desc: "slice of any", V: []any{1, int8(2), "3"}, Exp: "[]interface{}{\n\t1,\n\tint8(2),\n\t\"3\",\n}"},
+		{Desc: "array", V: [2]bool{true}, Exp: "[2]bool{\n\ttrue,\n\tfalse,\n}"},
+		{Desc: "byte slice", V: []byte("foo"), Exp: `[]byte("foo")`},
+		{Desc: "map", V: map[string]int{"b": 2, "a": 1}, Exp: "map[string]int{\n\t\"a\": 1,\n\t\"b\": 2,\n}"},
+		{Desc: "pointer to scalar", V: &zip, Exp: "func() *int { v := 1234; return &v }()"},
+		{Desc: "nil pointer", V: (*GoLiteralAddress)(nil), Exp: "(*pp_test.GoLiteralAddress)(nil)"},
+		{Desc: "time", V: createdAt, Exp: "time.Date(2024, time.January, 2, 15, 4, 5, 6, time.UTC)"},
+		{Desc: "time with a zone", V: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600)), Exp: `time.Date(2024, time.March, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))`},
+		{Desc: "duration", V: 90 * time.Second, Exp: "90 * time.Second"},
+		{Desc: "duration in nanoseconds", V: 1500 * time.Nanosecond, Exp: "1500 * time.Nanosecond"},
+		{Desc: "empty struct", V: GoLiteralUser{}, Exp: "pp_test.GoLiteralUser{}"},
+		{Desc: "struct", V: &GoLiteralUser{
+			Name:      "Jane",
+			Age:       42,
+			Status:    1,
+			Tags:      []string{"admin"},
+			Attrs:     map[string]any{"score": 4.2, "level": int64(3)},
+			Address:   &GoLiteralAddress{City: "Budapest", Zip: &zip},
+			CreatedAt: createdAt,
+			Timeout:   time.Minute,
+			Data:      []byte(`{"foo":"bar"}`),
+			secret:    "s3cr3t",
+		}, Exp: `&pp_test.GoLiteralUser{
+	Name:   "Jane",
+	Age:    42,
+	Status: 1,
+	Tags: []string{
+		"admin",
+	},
+	Attrs: map[string]interface{}{
+		"level": int64(3),
+		"score": 4.2,
+	},
+	Address: &pp_test.GoLiteralAddress{
+		City: "Budapest",
+		Zip:  func() *int { v := 1234; return &v }(),
+	},
+	CreatedAt: time.Date(2024, time.January, 2, 15, 4, 5, 6, time.UTC),
+	Timeout:   1 * time.Minute,
+	Data:      []byte("{\"foo\":\"bar\"}"),
+}`},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			got := pp.GoLiteral(tc.V)
+			assert.Equal(t, tc.Exp, got)
+			_, err := parser.ParseExpr(got)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("recursive value", func(t *testing.T) {
+		type Node struct{ Next *Node }
+		n := &Node{}
+		n.Next = n
+		got := pp.GoLiteral(n)
+		assert.Contains(t, got, "/* recursion */")
+		_, err := parser.ParseExpr(got)
+		assert.NoError(t, err)
+	})
+}
@@ -20,6 +20,53 @@ func ExampleFormat() {
 	})
 }
 
+func ExampleFormatter() {
+	type Config struct {
+		Name     string
+		Password string `pp:"redact"`
+		Cache    []byte `pp:"-"`
+		Hosts    []string
+	}
+	f := pp.Formatter{MaxDepth: 3, MaxLength: 10, Color: true}
+	_ = f.Format(Config{Name: "prod", Password: "s3cr3t"})
+
+	// configure the default formatter,
+	// which is also used by the failure messages of the assert package.
+	pp.DefaultFormatter = pp.Formatter{MaxLength: 10}
+}
+
+func ExampleRegisterFormat() {
+	type Money struct {
+		Amount   int
+		Currency string
+	}
+	var _ = pp.RegisterFormat[Money](func(m Money) string {
+		return fmt.Sprintf("Money(%d %s)", m.Amount, m.Currency)
+	})
+	_ = pp.Format(Money{Amount: 42, Currency: "EUR"}) // Money(42 EUR)
+}
+
+func ExampleGoLiteral() {
+	_ = pp.GoLiteral(&ExampleStruct{
+		A: "The Answer",
+		B: 42,
+	})
+	// &pp_test.ExampleStruct{
+	// 	A: "The Answer",
+	// 	B: 42,
+	// }
+}
+
+func ExampleDiffStructural() {
+	_ = pp.DiffStructural(ExampleStruct{
+		A: "The Answer",
+		B: 42,
+	}, ExampleStruct{
+		A: "The Question",
+		B: 42,
+	}) // .A: "The Answer" → "The Question"
+}
+
 func ExampleDiff() {
 	pp.DiffFormat(ExampleStruct{
 		A: "The Answer",
@@ -161,12 +161,20 @@ func (f *Factory) kindStruct(rnd *Random, T reflect.Type) any {
 	numField := rStruct.NumField()
 	for i := 0; i < numField; i++ {
 		field := rStruct.Field(i)
-		// structField := rStruct.Type().Field(i)
-
-		if field.CanSet() /* && f.getConfig().CanPopulateStructField(structField) */ {
-			if newValue := reflect.ValueOf(f.Make(rnd, field.Interface())); newValue.IsValid() {
-				field.Set(newValue)
-			}
+		structField := rStruct.Type().Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		tag := parseStructFieldTag(structField)
+		if tag.Skip {
+			continue
+		}
+		if !tag.IsZero() {
+			field.Set(f.makeTagged(rnd, structField, field.Type(), tag))
+			continue
+		}
+		if newValue := reflect.ValueOf(f.Make(rnd, field.Interface())); newValue.IsValid() {
+			field.Set(newValue)
 		}
 	}
 	return rStruct.Interface()
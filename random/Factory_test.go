@@ -1,7 +1,9 @@
 package random_test
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
@@ -830,6 +832,88 @@ func TestFactory(t *testing.T) {
 
 }
 
+func TestFactoryMake_structTags(t *testing.T) {
+	type Role string
+	type User struct {
+		ID       string   `random:"uuid"`
+		Email    string   `random:"email"`
+		Age      int      `random:"min=18,max=99"`
+		Score    float64  `random:"min=0.5,max=1.5"`
+		Level    uint8    `random:"min=250"`
+		Role     Role     `random:"oneof=admin|editor|viewer"`
+		Priority int      `random:"oneof=1|2|3"`
+		Name     string   `random:"len=3..10"`
+		Tags     []string `random:"len=2"`
+		Code     string   `random:"regexp=^[A-Z]{3}-\\d{2,4}$"`
+		Nick     *string  `random:"len=5"`
+		Notes    string   `random:"-"`
+		Other    string
+	}
+	var (
+		rnd      = random.New(random.CryptoSeed{})
+		ff       = &random.Factory{}
+		emailRGX = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+		uuidRGX  = regexp.MustCompile(`^[0-9A-F]{8}-[0-9A-F]{4}-4[0-9A-F]{3}-[89AB][0-9A-F]{3}-[0-9A-F]{12}$`)
+		codeRGX  = regexp.MustCompile(`^[A-Z]{3}-\d{2,4}$`)
+	)
+	for i := 0; i < 128; i++ {
+		u := ff.Make(rnd, User{}).(User)
+		assert.True(t, uuidRGX.MatchString(u.ID), assert.Message(u.ID))
+		assert.True(t, emailRGX.MatchString(u.Email), assert.Message(u.Email))
+		assert.Between(t, u.Age, 18, 99)
+		assert.Between(t, u.Score, 0.5, 1.5)
+		assert.Between(t, u.Level, uint8(250), uint8(255))
+		assert.Contains(t, []Role{"admin", "editor", "viewer"}, u.Role)
+		assert.Between(t, u.Priority, 1, 3)
+		assert.Between(t, len(u.Name), 3, 10)
+		assert.Equal(t, len(u.Tags), 2)
+		assert.True(t, codeRGX.MatchString(u.Code), assert.Message(u.Code))
+		assert.NotNil(t, u.Nick)
+		assert.Equal(t, len(*u.Nick), 5)
+		assert.Empty(t, u.Notes)
+		assert.NotEmpty(t, u.Other)
+	}
+}
+
+func TestFactoryMake_structTagsInvalid(t *testing.T) {
+	var (
+		rnd = random.New(random.CryptoSeed{})
+		ff  = &random.Factory{}
+	)
+	for desc, T := range map[string]any{
+		"unknown option": struct {
+			V string `random:"foo"`
+		}{},
+		"min greater than max": struct {
+			V int `random:"min=10,max=1"`
+		}{},
+		"min out of the type's range": struct {
+			V int8 `random:"min=300"`
+		}{},
+		"string option on a number": struct {
+			V int `random:"email"`
+		}{},
+		"min on a string": struct {
+			V string `random:"min=1"`
+		}{},
+		"invalid oneof value": struct {
+			V int `random:"oneof=a|b"`
+		}{},
+		"invalid regexp": struct {
+			V string `random:"regexp=[a-"`
+		}{},
+		"invalid len": struct {
+			V string `random:"len=10..3"`
+		}{},
+	} {
+		T := T
+		t.Run(desc, func(t *testing.T) {
+			out := assert.Panic(t, func() { ff.Make(rnd, T) })
+			assert.Contains(t, fmt.Sprint(out), "random: invalid struct tag on the V field")
+		})
+	}
+}
+
 func TestFactoryMake_race(t *testing.T) {
 	var (
 		rnd = random.New(random.CryptoSeed{})
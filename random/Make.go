@@ -1,5 +1,17 @@
 package random
 
+// Make makes a random value of the type of T.
+// Struct fields can be constrained with the random struct tag:
+//
+//   - `random:"-"` leaves the field as its zero value
+//   - `random:"min=18,max=99"` makes a number in the inclusive range, either bound can be omitted
+//   - `random:"len=3..10"` or `random:"len=5"` makes a string, slice or map with the given length
+//   - `random:"oneof=a|b|c"` picks one of the values
+//   - `random:"email"` and `random:"uuid"` make a valid email address or UUID
+//   - `random:"regexp=^[A-Z]{3}$"` makes a string matching the regular expression, it must be the last option
+//
+// Pointer fields get a pointer to a value made with the constraints.
+// An invalid tag panics, as it is a mistake in the test code.
 func (r *Random) Make(T any) any {
 	return r.Factory.Make(r, T)
 }
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -324,22 +325,60 @@ func (r *Random) UUID() string {
 type Contact struct {
 	FirstName string
 	LastName  string
-	Email     string
+	// FullName is the first and the last name in the order of the contact's locale.
+	FullName string
+	Email    string
 }
 
+// Contact returns a random contact.
+// By default, the contact has an en_US name,
+// which can be changed with a Locale option, like random.Locale("ja_JP").
 func (r *Random) Contact(opts ...internal.ContactOption) Contact {
 	conf := internal.ToContactConfig(opts...)
-	cg := contactGenerator{Random: r}
-	var c Contact
-	c.FirstName = cg.first(conf)
-	c.LastName = cg.last()
-	c.Email = cg.email(c.FirstName, c.LastName)
+	cg := contactGenerator{Random: r, Locale: getLocale(conf.Locale)}
+	var (
+		c     Contact
+		first = cg.first(conf)
+		last  = cg.last()
+	)
+	c.FirstName = first.Native
+	c.LastName = last.Native
+	c.FullName = cg.fullName(first.Native, last.Native)
+	c.Email = cg.email(first.Latin, last.Latin)
 	return c
 }
 
-type contactGenerator struct{ Random *Random }
+// Locale is a Contact option to make contacts of the locale.
+// The names are in the locale's native script, and the email addresses use their latin transliteration.
+// Supported locales are en_US, hu_HU and ja_JP.
+//
+//	rnd.Contact(random.Locale("hu_HU"))
+func Locale(code string) internal.ContactOption {
+	return internal.ContactLocale(code)
+}
+
+func getLocale(code internal.ContactLocale) fixture.Locale {
+	if code == "" {
+		code = fixture.DefaultLocale
+	}
+	l, ok := fixture.Values.Locales[string(code)]
+	if !ok {
+		var codes []string
+		for code := range fixture.Values.Locales {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		panic(fmt.Sprintf("random: unsupported locale %q, supported locales: %s", code, strings.Join(codes, ", ")))
+	}
+	return l
+}
+
+type contactGenerator struct {
+	Random *Random
+	Locale fixture.Locale
+}
 
-func (cg contactGenerator) first(conf internal.ContactConfig) string {
+func (cg contactGenerator) first(conf internal.ContactConfig) fixture.Name {
 	sexType := conf.SexType
 	switch sexType {
 	case internal.SexTypeAny, 0:
@@ -347,16 +386,23 @@ func (cg contactGenerator) first(conf internal.ContactConfig) string {
 	}
 	switch sexType {
 	case internal.SexTypeMale:
-		return cg.Random.Pick(fixture.Values.Names.Male).(string)
+		return cg.Random.Pick(cg.Locale.Names.Male).(fixture.Name)
 	case internal.SexTypeFemale:
-		return cg.Random.Pick(fixture.Values.Names.Female).(string)
+		return cg.Random.Pick(cg.Locale.Names.Female).(fixture.Name)
 	default:
 		panic("not implemented")
 	}
 }
 
-func (cg contactGenerator) last() string {
-	return cg.Random.Pick(fixture.Values.Names.Last).(string)
+func (cg contactGenerator) last() fixture.Name {
+	return cg.Random.Pick(cg.Locale.Names.Last).(fixture.Name)
+}
+
+func (cg contactGenerator) fullName(firstName, lastName string) string {
+	if cg.Locale.FamilyNameFirst {
+		return lastName + cg.Locale.NameSeparator + firstName
+	}
+	return firstName + cg.Locale.NameSeparator + lastName
 }
 
 func (cg contactGenerator) email(firstName, lastName string) string {
@@ -365,7 +411,7 @@ func (cg contactGenerator) email(firstName, lastName string) string {
 		cg.Random.Pick([]string{"_", "."}).(string),
 		strings.ToLower(lastName),
 		strconv.Itoa(cg.Random.IntB(0, 42)),
-		cg.Random.Pick(fixture.Values.EmailDomains).(string))
+		cg.Random.Pick(cg.Locale.EmailDomains).(string))
 }
 
 // Repeat will repeatedly call the "do" function.
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
+	"unicode"
 
 	"go.llib.dev/testcase/internal"
 	"go.llib.dev/testcase/let"
@@ -542,6 +545,224 @@ func SpecRandomMethods(s *testcase.Spec, rnd testcase.Var[*random.Random]) {
 		})
 	})
 
+	s.Describe(".StringFromRegexp", func(s *testcase.Spec) {
+		expr := let.Var[string](s, nil)
+		act := func(t *testcase.T) string {
+			return rnd.Get(t).StringFromRegexp(expr.Get(t))
+		}
+
+		s.Test("the result matches the expression", func(t *testcase.T) {
+			for _, e := range []string{
+				`^[A-Z]{3}-\d{4}$`,
+				`^[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,4}$`,
+				`^(foo|bar|baz)+$`,
+				`^\w+\s?\W*$`,
+				`^[^a-z]{5}$`,
+				`(?i)^hello$`,
+				`^\d{4} [A-Z]{2}$`,
+				`^.*$`,
+				`^$`,
+			} {
+				rgx := regexp.MustCompile(e)
+				t.Random.Repeat(8, 16, func() {
+					expr.Set(t, e)
+					got := act(t)
+					assert.True(t, rgx.MatchString(got), assert.Message(fmt.Sprintf("%s: %q", e, got)))
+				})
+			}
+		})
+
+		s.Test("unbounded repetitions are bounded", func(t *testcase.T) {
+			expr.Set(t, `^a*b+$`)
+			t.Random.Repeat(32, 64, func() {
+				got := act(t)
+				assert.True(t, len(got) <= 10+11, assert.Message(got))
+				assert.True(t, strings.HasSuffix(got, "b"))
+			})
+		})
+
+		s.Test("it generates various results", func(t *testcase.T) {
+			expr.Set(t, `^[a-z]{16}$`)
+			assert.NotEqual(t, act(t), act(t))
+		})
+
+		s.Test("the result is reproducible with the same seed", func(t *testcase.T) {
+			expr.Set(t, `^[A-Z]{2,8}(-\d+)*$`)
+			seed := int64(t.Random.Int())
+			rnd.Get(t).Source = rand.NewSource(seed)
+			s1 := act(t)
+			rnd.Get(t).Source = rand.NewSource(seed)
+			s2 := act(t)
+			assert.Equal(t, s1, s2)
+		})
+
+		s.Test("invalid expression panics", func(t *testcase.T) {
+			expr.Set(t, `[a-`)
+			assert.Panic(t, func() { act(t) })
+		})
+
+		s.Test("expression that can't match panics", func(t *testcase.T) {
+			for _, e := range []string{
+				`[^\x00-\x{10FFFF}]`,
+				`a\bb`,
+				`foo$bar`,
+				`a^b`,
+			} {
+				expr.Set(t, e)
+				assert.Panic(t, func() { act(t) }, assert.Message(e))
+			}
+		})
+
+		s.Test("anchors and word boundaries at the edges are satisfied", func(t *testcase.T) {
+			for _, e := range []string{`\bfoo\b`, `(?m)^foo$`, `\Abar\z`, `foo\B[a-z]`} {
+				rgx := regexp.MustCompile(e)
+				expr.Set(t, e)
+				got := act(t)
+				assert.True(t, rgx.MatchString(got), assert.Message(fmt.Sprintf("%s: %q", e, got)))
+			}
+		})
+
+		s.Test("case insensitive ASCII literals stay ASCII", func(t *testcase.T) {
+			expr.Set(t, `(?i)^kiss$`)
+			t.Random.Repeat(32, 64, func() {
+				got := act(t)
+				assert.True(t, regexp.MustCompile(`^[kKiIsS]{4}$`).MatchString(got), assert.Message(got))
+			})
+		})
+	})
+
+	s.Describe(".Address", func(s *testcase.Spec) {
+		act := func(t *testcase.T) random.Address {
+			return rnd.Get(t).Address()
+		}
+
+		s.Then("all the address fields are populated", func(t *testcase.T) {
+			a := act(t)
+			assert.NotEmpty(t, a.Street)
+			assert.NotEmpty(t, a.City)
+			assert.NotEmpty(t, a.Postcode)
+			assert.True(t, regexp.MustCompile(`^[A-Z]{2}$`).MatchString(a.Country))
+		})
+
+		s.Then("the postcode follows the country's format", func(t *testcase.T) {
+			t.Random.Repeat(8, 16, func() {
+				a := act(t)
+				switch a.Country {
+				case "US", "DE", "FR", "ES", "IT":
+					assert.True(t, regexp.MustCompile(`^\d{5}$`).MatchString(a.Postcode), assert.Message(a.Postcode))
+				case "HU", "AU":
+					assert.True(t, regexp.MustCompile(`^\d{4}$`).MatchString(a.Postcode), assert.Message(a.Postcode))
+				case "JP":
+					assert.True(t, regexp.MustCompile(`^\d{3}-\d{4}$`).MatchString(a.Postcode), assert.Message(a.Postcode))
+				}
+			})
+		})
+
+		s.Then("it generates various addresses", func(t *testcase.T) {
+			assert.NotEqual(t, act(t), act(t))
+		})
+	})
+
+	s.Describe(".PhoneNumber", func(s *testcase.Spec) {
+		act := func(t *testcase.T) string {
+			return rnd.Get(t).PhoneNumber()
+		}
+
+		s.Then("it is in the E.164 format", func(t *testcase.T) {
+			t.Random.Repeat(8, 16, func() {
+				got := act(t)
+				assert.True(t, regexp.MustCompile(`^\+[1-9]\d{7,14}$`).MatchString(got), assert.Message(got))
+			})
+		})
+	})
+
+	s.Describe(".IBAN", func(s *testcase.Spec) {
+		act := func(t *testcase.T) string {
+			return rnd.Get(t).IBAN()
+		}
+
+		s.Then("it has valid mod-97 check digits", func(t *testcase.T) {
+			t.Random.Repeat(8, 16, func() {
+				got := act(t)
+				assert.True(t, regexp.MustCompile(`^[A-Z]{2}\d{2}[0-9A-Z]{11,30}$`).MatchString(got), assert.Message(got))
+				assert.True(t, isValidIBAN(got), assert.Message(got))
+			})
+		})
+	})
+
+	s.Describe(".CreditCardNumber", func(s *testcase.Spec) {
+		act := func(t *testcase.T) string {
+			return rnd.Get(t).CreditCardNumber()
+		}
+
+		s.Then("it passes the Luhn check", func(t *testcase.T) {
+			t.Random.Repeat(8, 16, func() {
+				got := act(t)
+				assert.True(t, regexp.MustCompile(`^\d{14,16}$`).MatchString(got), assert.Message(got))
+				assert.True(t, isValidLuhn(got), assert.Message(got))
+			})
+		})
+	})
+
+	s.Describe(".IPv4", func(s *testcase.Spec) {
+		s.Then("it is a parsable IPv4 address", func(t *testcase.T) {
+			got := rnd.Get(t).IPv4()
+			ip := net.ParseIP(got)
+			assert.NotNil(t, ip.To4(), assert.Message(got))
+			assert.False(t, ip.IsLoopback())
+			assert.False(t, ip.IsUnspecified())
+		})
+	})
+
+	s.Describe(".IPv6", func(s *testcase.Spec) {
+		s.Then("it is a parsable global unicast IPv6 address", func(t *testcase.T) {
+			got := rnd.Get(t).IPv6()
+			ip := net.ParseIP(got)
+			assert.NotNil(t, ip, assert.Message(got))
+			assert.Nil(t, ip.To4())
+			assert.True(t, ip.IsGlobalUnicast())
+		})
+	})
+
+	s.Describe(".MAC", func(s *testcase.Spec) {
+		s.Then("it is a parsable unicast MAC address", func(t *testcase.T) {
+			got := rnd.Get(t).MAC()
+			mac, err := net.ParseMAC(got)
+			assert.NoError(t, err)
+			assert.Equal(t, len(mac), 6)
+			assert.Equal(t, mac[0]&0x01, 0, "unicast")
+		})
+	})
+
+	s.Describe(".URL", func(s *testcase.Spec) {
+		s.Then("it is a parsable https URL", func(t *testcase.T) {
+			got := rnd.Get(t).URL()
+			u, err := url.Parse(got)
+			assert.NoError(t, err)
+			assert.Equal(t, u.Scheme, "https")
+			assert.NotEmpty(t, u.Host)
+			assert.NotEmpty(t, u.Path)
+		})
+	})
+
+	s.Describe(".UserAgent", func(s *testcase.Spec) {
+		s.Then("a non empty user agent is returned", func(t *testcase.T) {
+			assert.NotEmpty(t, rnd.Get(t).UserAgent())
+		})
+	})
+
+	s.Describe(".CompanyName", func(s *testcase.Spec) {
+		s.Then("a non empty company name is returned", func(t *testcase.T) {
+			assert.NotEmpty(t, rnd.Get(t).CompanyName())
+		})
+
+		s.Then("it generates various names", func(t *testcase.T) {
+			t.Eventually(func(it *testcase.T) {
+				assert.NotEqual(it, rnd.Get(t).CompanyName(), rnd.Get(t).CompanyName())
+			})
+		})
+	})
+
 	s.Describe(".Contact", func(s *testcase.Spec) {
 		opts := testcase.LetValue[[]internal.ContactOption](s, nil)
 		act := func(t *testcase.T) random.Contact {
@@ -667,6 +888,96 @@ func SpecRandomMethods(s *testcase.Spec, rnd testcase.Var[*random.Random]) {
 				})
 			})
 		})
+
+		s.Context(".FullName", func(s *testcase.Spec) {
+			s.Then("it is the first name followed by the last name", func(t *testcase.T) {
+				c := act(t)
+				assert.Equal(t, c.FullName, c.FirstName+" "+c.LastName)
+			})
+		})
+
+		s.When("hu_HU locale is provided", func(s *testcase.Spec) {
+			opts.Let(s, func(t *testcase.T) []internal.ContactOption {
+				return []internal.ContactOption{random.Locale("hu_HU")}
+			})
+
+			s.Then("it occasionally returns a name with hungarian accents", func(t *testcase.T) {
+				t.Eventually(func(it *testcase.T) {
+					c := act(t)
+					assert.True(it, strings.ContainsAny(c.FirstName+c.LastName, "áéíóöőúüű"))
+				})
+			})
+
+			s.Then("the full name starts with the family name", func(t *testcase.T) {
+				c := act(t)
+				assert.Equal(t, c.FullName, c.LastName+" "+c.FirstName)
+			})
+
+			s.Then("the email is transliterated to ASCII", func(t *testcase.T) {
+				t.Random.Repeat(8, 16, func() {
+					c := act(t)
+					assert.True(t, regexp.MustCompile(`^[a-z]+[._][a-z]+\d+@[a-z.-]+$`).MatchString(c.Email), assert.Message(c.Email))
+				})
+			})
+
+			s.Then("it can be combined with a sex type", func(t *testcase.T) {
+				t.Eventually(func(it *testcase.T) {
+					assert.Equal(it, "László", rnd.Get(t).Contact(random.Locale("hu_HU"), sextype.Male).FirstName)
+				})
+			})
+		})
+
+		s.When("ja_JP locale is provided", func(s *testcase.Spec) {
+			opts.Let(s, func(t *testcase.T) []internal.ContactOption {
+				return []internal.ContactOption{random.Locale("ja_JP")}
+			})
+
+			s.Then("the names are in native script", func(t *testcase.T) {
+				c := act(t)
+				for _, r := range c.FirstName + c.LastName {
+					assert.True(t, unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana), assert.Message(c.FirstName+c.LastName))
+				}
+			})
+
+			s.Then("the full name is the family name followed by the given name without a space", func(t *testcase.T) {
+				c := act(t)
+				assert.Equal(t, c.FullName, c.LastName+c.FirstName)
+			})
+
+			s.Then("the email is romanised", func(t *testcase.T) {
+				t.Random.Repeat(8, 16, func() {
+					c := act(t)
+					assert.True(t, regexp.MustCompile(`^[a-z]+[._][a-z]+\d+@[a-z.-]+$`).MatchString(c.Email), assert.Message(c.Email))
+				})
+			})
+
+			s.Then("it returns a japanese email domain occasionally", func(t *testcase.T) {
+				t.Eventually(func(it *testcase.T) {
+					assert.True(it, strings.HasSuffix(act(t).Email, ".jp"))
+				})
+			})
+		})
+
+		s.Test("without a locale, the contacts of a seed are the same as before the locale support", func(t *testcase.T) {
+			rnd := random.New(rand.NewSource(42))
+			assert.Equal(t, []random.Contact{
+				{FirstName: "Samantha", LastName: "Williams", FullName: "Samantha Williams", Email: "samantha_williams29@live.nl"},
+				{FirstName: "Melissa", LastName: "Martinez", FullName: "Melissa Martinez", Email: "melissa.martinez41@sympatico.ca"},
+				{FirstName: "Diana", LastName: "Campbell", FullName: "Diana Campbell", Email: "diana.campbell37@alice.it"},
+			}, []random.Contact{rnd.Contact(), rnd.Contact(), rnd.Contact()})
+		})
+
+		s.When("unsupported locale is provided", func(s *testcase.Spec) {
+			opts.Let(s, func(t *testcase.T) []internal.ContactOption {
+				return []internal.ContactOption{random.Locale("xx_XX")}
+			})
+
+			s.Then("it panics with the supported locales", func(t *testcase.T) {
+				out := assert.Panic(t, func() { act(t) })
+				assert.Contains(t, fmt.Sprint(out), "xx_XX")
+				assert.Contains(t, fmt.Sprint(out), "hu_HU")
+			})
+		})
 	})
 
 	s.Describe(".Repeat", func(s *testcase.Spec) {
@@ -1302,3 +1613,39 @@ func ParseUUID(raw string) ([16]byte, error) {
 	}
 	return uuid, nil
 }
+
+func isValidIBAN(iban string) bool {
+	var (
+		rearranged = iban[4:] + iban[:4]
+		mod        int
+	)
+	for _, c := range rearranged {
+		var n int
+		switch {
+		case '0' <= c && c <= '9':
+			n = int(c - '0')
+			mod = (mod*10 + n) % 97
+		case 'A' <= c && c <= 'Z':
+			n = int(c-'A') + 10
+			mod = (mod*100 + n) % 97
+		default:
+			return false
+		}
+	}
+	return mod == 1
+}
+
+func isValidLuhn(number string) bool {
+	var sum int
+	for i := len(number) - 1; 0 <= i; i-- {
+		d := int(number[i] - '0')
+		if (len(number)-i)%2 == 0 {
+			d *= 2
+			if 9 < d {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
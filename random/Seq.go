@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package random
+
+import "iter"
+
+// Seq returns a lazy iterator, which makes length number of random values with the mk function.
+// The values are only made when the iterator is consumed, and each iteration makes new values.
+// A negative length makes an endless stream, which is stopped by the consumer.
+func Seq[T any](length int, mk func() T, opts ...sliceOption) iter.Seq[T] {
+	var c sliceConfig
+	c.use(opts)
+	return func(yield func(T) bool) {
+		var made []T
+		for i := 0; length < 0 || i < length; i++ {
+			var v T
+			if c.Unique {
+				v = Unique(mk, made...)
+				made = append(made, v)
+			} else {
+				v = mk()
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns a lazy iterator, which makes length number of random key-value pairs with the mk function.
+// A negative length makes an endless stream, which is stopped by the consumer.
+func Seq2[K, V any](length int, mk func() (K, V)) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := 0; length < 0 || i < length; i++ {
+			if !yield(mk()) {
+				return
+			}
+		}
+	}
+}
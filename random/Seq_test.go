@@ -0,0 +1,56 @@
+//go:build go1.23
+
+package random_test
+
+import (
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/random"
+)
+
+func TestSeq(t *testing.T) {
+	rnd := random.New(random.CryptoSeed{})
+
+	t.Run("it yields length number of values", func(t *testing.T) {
+		var got []int
+		random.Seq(3, rnd.Int)(func(v int) bool {
+			got = append(got, v)
+			return true
+		})
+		assert.Equal(t, 3, len(got))
+	})
+
+	t.Run("values are made lazily, only when they are consumed", func(t *testing.T) {
+		var made int
+		seq := random.Seq(10, func() int { made++; return rnd.Int() })
+		assert.Equal(t, 0, made)
+		seq(func(int) bool { return made < 2 })
+		assert.Equal(t, 2, made)
+	})
+
+	t.Run("negative length makes an endless stream", func(t *testing.T) {
+		var count int
+		random.Seq(-1, rnd.Int)(func(int) bool {
+			count++
+			return count < 1000
+		})
+		assert.Equal(t, 1000, count)
+	})
+
+	t.Run("unique values", func(t *testing.T) {
+		seq := random.Seq(5, func() int { return rnd.IntN(10) }, random.UniqueValues)
+		assert.SeqUnique(t, seq)
+	})
+
+	t.Run("Seq2", func(t *testing.T) {
+		seq := random.Seq2(3, random.KV(rnd.Int, rnd.String))
+		var count int
+		seq(func(int, string) bool {
+			count++
+			return true
+		})
+		assert.Equal(t, 3, count)
+		assert.Seq2Empty(t, random.Seq2(0, random.KV(rnd.Int, rnd.String)))
+	})
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package random_test
+
+import (
+	"go.llib.dev/testcase/random"
+)
+
+func ExampleSeq() {
+	rnd := random.New(random.CryptoSeed{})
+
+	for v := range random.Seq(-1, rnd.Int) {
+		if v%2 == 0 {
+			break // an endless stream is stopped by the consumer
+		}
+	}
+}
+
+func ExampleSeq2() {
+	rnd := random.New(random.CryptoSeed{})
+
+	for k, v := range random.Seq2(3, func() (string, int) { return rnd.String(), rnd.Int() }) {
+		_, _ = k, v
+	}
+}
@@ -34,6 +34,22 @@ func ExampleRandom_Make() {
 	_ = rnd.Make(&ExampleStruct{}).(*ExampleStruct) // returns a populated struct
 }
 
+func ExampleRandom_Make_withStructTags() {
+	rnd := random.New(random.CryptoSeed{})
+
+	type User struct {
+		ID    string `random:"uuid"`
+		Email string `random:"email"`
+		Age   int    `random:"min=18,max=99"`
+		Role  string `random:"oneof=admin|editor|viewer"`
+		Name  string `random:"len=3..10"`
+		Code  string `random:"regexp=^[A-Z]{3}$"`
+		Notes string `random:"-"`
+	}
+
+	_ = rnd.Make(User{}).(User)
+}
+
 func ExampleNew() {
 	_ = random.New(rand.NewSource(time.Now().Unix()))
 }
@@ -209,3 +225,31 @@ func ExampleRandom_DurationBetween() {
 
 	rnd.DurationBetween(time.Second, time.Minute)
 }
+
+func ExampleRandom_Address() {
+	rnd := random.New(random.CryptoSeed{})
+
+	addr := rnd.Address()
+	_ = addr.Street   // "212 King Street"
+	_ = addr.City     // "Paris"
+	_ = addr.Postcode // "75009"
+	_ = addr.Country  // "FR"
+}
+
+func ExampleRandom_IBAN() {
+	rnd := random.New(random.CryptoSeed{})
+
+	_ = rnd.IBAN()             // "DE89370400440532013000", with valid mod-97 check digits
+	_ = rnd.CreditCardNumber() // "4111111111111111", with a valid Luhn check digit
+	_ = rnd.PhoneNumber()      // "+36201234567", in E.164 format
+}
+
+func ExampleLocale() {
+	rnd := random.New(random.CryptoSeed{})
+
+	c := rnd.Contact(random.Locale("ja_JP"))
+	_ = c.FirstName // "翔太"
+	_ = c.LastName  // "山田"
+	_ = c.FullName  // "山田翔太"
+	_ = c.Email     // "shota.yamada7@yahoo.co.jp"
+}
@@ -0,0 +1,136 @@
+package random
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"go.llib.dev/testcase/random/internal/fixture"
+)
+
+type Address struct {
+	Street   string
+	City     string
+	Postcode string
+	Country  string // ISO 3166-1 alpha-2 code
+}
+
+// Address returns a random postal address.
+// The postcode follows the format of the city's country.
+func (r *Random) Address() Address {
+	city := Pick(r, fixture.Values.Address.Cities...)
+	return Address{
+		Street:   fmt.Sprintf("%d %s", r.IntBetween(1, 999), r.Pick(fixture.Values.Address.Streets).(string)),
+		City:     city.Name,
+		Postcode: r.StringFromRegexp(city.Postcode),
+		Country:  city.Country,
+	}
+}
+
+// PhoneNumber returns a random phone number in the E.164 format, like +36201234567.
+func (r *Random) PhoneNumber() string {
+	pc := Pick(r, fixture.Values.PhoneCountries...)
+	return "+" + pc.CallingCode + r.StringNC(1, "23456789") + r.StringNC(pc.NationalNumberLength-1, charsetDigit)
+}
+
+// IBAN returns a random International Bank Account Number with valid check digits.
+func (r *Random) IBAN() string {
+	ic := Pick(r, fixture.Values.IBANCountries...)
+	bban := r.StringFromRegexp(ic.BBAN)
+	return fmt.Sprintf("%s%02d%s", ic.Country, ibanCheckDigits(ic.Country, bban), bban)
+}
+
+// ibanCheckDigits calculates the check digits with the mod-97 algorithm of ISO 13616.
+func ibanCheckDigits(country, bban string) int {
+	var digits strings.Builder
+	for _, c := range bban + country + "00" {
+		if 'A' <= c && c <= 'Z' {
+			_, _ = fmt.Fprintf(&digits, "%d", c-'A'+10)
+			continue
+		}
+		digits.WriteRune(c)
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	return 98 - int(new(big.Int).Mod(n, big.NewInt(97)).Int64())
+}
+
+// CreditCardNumber returns a random payment card number of a major card scheme,
+// with a valid Luhn check digit.
+func (r *Random) CreditCardNumber() string {
+	cs := Pick(r, fixture.Values.CardSchemes...)
+	payload := cs.Prefix + r.StringNC(cs.Length-len(cs.Prefix)-1, charsetDigit)
+	return payload + string(rune('0'+luhnCheckDigit(payload)))
+}
+
+// luhnCheckDigit calculates the check digit of the payload with the Luhn algorithm.
+func luhnCheckDigit(payload string) int {
+	var sum int
+	for i := len(payload) - 1; 0 <= i; i-- {
+		d := int(payload[i] - '0')
+		if (len(payload)-i)%2 == 1 { // every second digit from the right, starting with the rightmost
+			d *= 2
+			if 9 < d {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+// IPv4 returns a random IPv4 address from the unicast address space, excluding the loopback range.
+func (r *Random) IPv4() string {
+	first := r.IntBetween(1, 222)
+	if first == 127 {
+		first = 223
+	}
+	return net.IPv4(byte(first), byte(r.IntN(256)), byte(r.IntN(256)), byte(r.IntBetween(1, 254))).String()
+}
+
+// IPv6 returns a random global unicast IPv6 address.
+func (r *Random) IPv6() string {
+	ip := make(net.IP, net.IPv6len)
+	r.mustRead(ip)
+	ip[0] = 0x20 | ip[0]&0x1F // 2000::/3
+	return ip.String()
+}
+
+// MAC returns a random unicast, locally administered MAC address.
+func (r *Random) MAC() string {
+	mac := make(net.HardwareAddr, 6)
+	r.mustRead(mac)
+	mac[0] = mac[0]&^0x01 | 0x02
+	return mac.String()
+}
+
+// URL returns a random https URL with a path.
+func (r *Random) URL() string {
+	var segments []string
+	r.Repeat(1, 3, func() {
+		segments = append(segments, r.Pick(fixture.Values.URLPaths).(string))
+	})
+	return "https://" + r.Domain() + "/" + strings.Join(segments, "/")
+}
+
+// UserAgent returns a random HTTP User-Agent header value.
+func (r *Random) UserAgent() string {
+	return r.Pick(fixture.Values.UserAgents).(string)
+}
+
+// CompanyName returns a random company name.
+func (r *Random) CompanyName() string {
+	var (
+		last     = func() string { return r.Pick(fixture.Values.Names.Last).(string) }
+		industry = func() string { return r.Pick(fixture.Values.Company.Industries).(string) }
+		suffix   = func() string { return r.Pick(fixture.Values.Company.Suffixes).(string) }
+	)
+	switch r.IntN(3) {
+	case 0:
+		return fmt.Sprintf("%s %s", last(), industry())
+	case 1:
+		return fmt.Sprintf("%s %s %s", last(), industry(), suffix())
+	default:
+		return fmt.Sprintf("%s & %s %s", last(), last(), suffix())
+	}
+}
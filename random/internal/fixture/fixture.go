@@ -19,6 +19,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strconv"
 )
 
 //go:embed assets/*
@@ -38,6 +39,71 @@ var Values struct {
 		Female []string
 		Last   []string
 	}
+
+	Address struct {
+		Streets []string
+		Cities  []City
+	}
+
+	PhoneCountries []PhoneCountry
+	IBANCountries  []IBANCountry
+	CardSchemes    []CardScheme
+
+	UserAgents []string
+	URLPaths   []string
+
+	Company struct {
+		Industries []string
+		Suffixes   []string
+	}
+
+	// Locales are the contact data packs by their locale code, like "hu_HU".
+	Locales map[string]Locale
+}
+
+// DefaultLocale is the locale of the Values.Names and the Values.EmailDomains.
+const DefaultLocale = "en_US"
+
+type Locale struct {
+	Names struct {
+		Male   []Name
+		Female []Name
+		Last   []Name
+	}
+	EmailDomains []string
+	// FamilyNameFirst tells if the family name precedes the given name in the locale's full names.
+	FamilyNameFirst bool
+	// NameSeparator is what separates the given name and the family name in the locale's full names.
+	NameSeparator string
+}
+
+// Name is a name in its native script, and its transliteration to the latin alphabet.
+type Name struct {
+	Native string
+	Latin  string
+}
+
+type City struct {
+	Country  string // ISO 3166-1 alpha-2 code
+	Name     string
+	Postcode string // regular expression of the city's postcodes
+}
+
+type PhoneCountry struct {
+	Country              string // ISO 3166-1 alpha-2 code
+	CallingCode          string
+	NationalNumberLength int
+}
+
+type IBANCountry struct {
+	Country string // ISO 3166-1 alpha-2 code
+	BBAN    string // regular expression of the country's Basic Bank Account Number format
+}
+
+type CardScheme struct {
+	Name   string
+	Prefix string
+	Length int
 }
 
 func init() {
@@ -48,7 +114,124 @@ func init() {
 	Values.Names.Male = getLines("contacts", "malenames.txt")
 	Values.Names.Female = getLines("contacts", "femalenames.txt")
 	Values.Domains = getDomains()
+	Values.Address.Streets = getLines("address", "streets.txt")
+	Values.Address.Cities = getCities()
+	Values.PhoneCountries = getPhoneCountries()
+	Values.IBANCountries = getIBANCountries()
+	Values.CardSchemes = getCardSchemes()
+	Values.UserAgents = getLines("network", "useragents.txt")
+	Values.URLPaths = getLines("network", "urlpaths.txt")
+	Values.Company.Industries = getLines("company", "industries.txt")
+	Values.Company.Suffixes = getLines("company", "suffixes.txt")
+	Values.Locales = getLocales()
+}
+
+func getLocales() map[string]Locale {
+	locales := make(map[string]Locale)
+	for _, record := range getRecords(3, "contacts", "locales.csv") {
+		var (
+			code = record[0]
+			l    Locale
+		)
+		if code == DefaultLocale {
+			l.Names.Male = toNames(Values.Names.Male)
+			l.Names.Female = toNames(Values.Names.Female)
+			l.Names.Last = toNames(Values.Names.Last)
+			l.EmailDomains = Values.EmailDomains
+		} else {
+			l.Names.Male = getNames("contacts", code, "malenames.csv")
+			l.Names.Female = getNames("contacts", code, "femalenames.csv")
+			l.Names.Last = getNames("contacts", code, "lastnames.csv")
+			l.EmailDomains = getLines("contacts", code, "emaildomains.txt")
+		}
+		l.FamilyNameFirst = record[1] == "family-given"
+		l.NameSeparator = record[2]
+		locales[code] = l
+	}
+	return locales
+}
+
+func getNames(paths ...string) []Name {
+	var names []Name
+	for _, record := range getRecords(2, paths...) {
+		names = append(names, Name{Native: record[0], Latin: record[1]})
+	}
+	return names
+}
+
+func toNames(vs []string) []Name {
+	var names []Name
+	for _, v := range vs {
+		names = append(names, Name{Native: v, Latin: v})
+	}
+	return names
+}
+
+func getCities() []City {
+	var cities []City
+	for _, record := range getRecords(3, "address", "cities.csv") {
+		cities = append(cities, City{Country: record[0], Name: record[1], Postcode: record[2]})
+	}
+	return cities
+}
+
+func getPhoneCountries() []PhoneCountry {
+	var pcs []PhoneCountry
+	for _, record := range getRecords(3, "phone", "countries.csv") {
+		length, err := strconv.Atoi(record[2])
+		if err != nil {
+			stderrLog(err)
+			return nil
+		}
+		pcs = append(pcs, PhoneCountry{Country: record[0], CallingCode: record[1], NationalNumberLength: length})
+	}
+	return pcs
+}
 
+func getIBANCountries() []IBANCountry {
+	var ics []IBANCountry
+	for _, record := range getRecords(2, "finance", "iban.csv") {
+		ics = append(ics, IBANCountry{Country: record[0], BBAN: record[1]})
+	}
+	return ics
+}
+
+func getCardSchemes() []CardScheme {
+	var css []CardScheme
+	for _, record := range getRecords(3, "finance", "cardschemes.csv") {
+		length, err := strconv.Atoi(record[2])
+		if err != nil {
+			stderrLog(err)
+			return nil
+		}
+		css = append(css, CardScheme{Name: record[0], Prefix: record[1], Length: length})
+	}
+	return css
+}
+
+// getRecords reads the records of a CSV asset, without its header.
+func getRecords(columns int, paths ...string) [][]string {
+	filePath := path.Join(fsDirName, path.Join(paths...))
+
+	file, err := Assets.Open(filePath)
+	if err != nil {
+		stderrLog(err)
+		return nil
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = columns
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		stderrLog(err)
+		return nil
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	return records[1:]
 }
 
 func getDomains() []string {
@@ -0,0 +1,221 @@
+package random
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// regexpMaxRepeat is the maximum number of extra repetitions made for the unbounded repetitions, like * or +.
+	regexpMaxRepeat = 10
+	// regexpMaxAttempts is the number of strings generated before giving up on an expression,
+	// whose anchors or word boundaries don't match the generated strings, like `a^b`.
+	regexpMaxAttempts = 100
+)
+
+// StringFromRegexp returns a random string that matches the regular expression.
+// The expression uses the regexp/syntax Perl flavour, the same as regexp.Compile.
+// Unbounded repetitions like * or + repeat at most 10 more times than their minimum,
+// and character classes prefer printable ASCII characters when they include any.
+// The result depends only on the Random's source, thus it is reproducible with the same seed.
+// It panics if the expression is invalid or can't match any string,
+// like an anchor or a word boundary in the middle of the text, as in `a^b`.
+//
+//	rnd.StringFromRegexp(`^[A-Z]{3}-\d{4}$`) // "QKD-0391"
+func (r *Random) StringFromRegexp(expr string) string {
+	str, err := r.stringFromRegexp(expr)
+	if err != nil {
+		panic(fmt.Sprintf("random: invalid regular expression: %s", err.Error()))
+	}
+	return str
+}
+
+// stringFromRegexp generates strings from the expression's syntax tree,
+// and checks them against the compiled expression,
+// as anchors and word boundaries are not generated, only expected to be satisfied by the generated text.
+func (r *Random) stringFromRegexp(expr string) (string, error) {
+	rgx, err := regexp.Compile(expr)
+	if err != nil {
+		return "", err
+	}
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	re = re.Simplify()
+	for i := 0; i < regexpMaxAttempts; i++ {
+		var sb strings.Builder
+		if err := (regexpGenerator{Random: r}).Generate(&sb, re); err != nil {
+			return "", fmt.Errorf("%s: %w", expr, err)
+		}
+		if str := sb.String(); rgx.MatchString(str) {
+			return str, nil
+		}
+	}
+	return "", fmt.Errorf("%s: the regular expression can't match any string", expr)
+}
+
+// regexpPrintableASCII is the range of printable ASCII characters in the regexp/syntax character class format.
+var regexpPrintableASCII = []rune{' ', '~'}
+
+type regexpGenerator struct{ Random *Random }
+
+func (g regexpGenerator) Generate(sb *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch,
+		syntax.OpBeginLine, syntax.OpEndLine,
+		syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return nil
+
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && g.Random.Bool() {
+				r = foldCase(r)
+			}
+			sb.WriteRune(r)
+		}
+		return nil
+
+	case syntax.OpCharClass:
+		r, ok := g.pickRune(re.Rune)
+		if !ok {
+			return fmt.Errorf("empty character class")
+		}
+		sb.WriteRune(r)
+		return nil
+
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		r, _ := g.pickRune(regexpPrintableASCII)
+		sb.WriteRune(r)
+		return nil
+
+	case syntax.OpCapture:
+		return g.Generate(sb, re.Sub[0])
+
+	case syntax.OpStar:
+		return g.repeat(sb, re.Sub[0], 0, -1)
+
+	case syntax.OpPlus:
+		return g.repeat(sb, re.Sub[0], 1, -1)
+
+	case syntax.OpQuest:
+		return g.repeat(sb, re.Sub[0], 0, 1)
+
+	case syntax.OpRepeat:
+		return g.repeat(sb, re.Sub[0], re.Min, re.Max)
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := g.Generate(sb, sub); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case syntax.OpAlternate:
+		return g.Generate(sb, re.Sub[g.Random.IntN(len(re.Sub))])
+
+	default: // syntax.OpNoMatch
+		return fmt.Errorf("the regular expression can't match any string")
+	}
+}
+
+// foldCase returns the other case of the rune.
+// ASCII letters are kept within ASCII, as unicode.SimpleFold would turn k into the Kelvin sign, or s into ſ.
+func foldCase(r rune) rune {
+	if r < utf8.RuneSelf {
+		switch {
+		case 'a' <= r && r <= 'z':
+			return r - 'a' + 'A'
+		case 'A' <= r && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return r
+		}
+	}
+	return unicode.SimpleFold(r)
+}
+
+// repeat generates the sub expression between min and max times.
+// A negative max means no upper limit, which is bounded by the regexpMaxRepeat.
+func (g regexpGenerator) repeat(sb *strings.Builder, sub *syntax.Regexp, min, max int) error {
+	if max < 0 {
+		max = min + regexpMaxRepeat
+	}
+	for i, n := 0, g.Random.IntBetween(min, max); i < n; i++ {
+		if err := g.Generate(sb, sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pickRune picks a rune from the character class ranges.
+// Printable ASCII characters are preferred when the class has any,
+// so negated classes like [^a] don't result in unreadable values.
+func (g regexpGenerator) pickRune(ranges []rune) (rune, bool) {
+	if printable := intersectRanges(ranges, regexpPrintableASCII); 0 < len(printable) {
+		ranges = printable
+	}
+	ranges = subtractSurrogates(ranges)
+	var total int
+	for i := 0; i+1 < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	if total == 0 {
+		return 0, false
+	}
+	n := g.Random.IntN(total)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n), true
+		}
+		n -= size
+	}
+	return 0, false
+}
+
+func intersectRanges(ranges, with []rune) []rune {
+	var out []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for j := 0; j+1 < len(with); j += 2 {
+			lo, hi := ranges[i], ranges[i+1]
+			if lo < with[j] {
+				lo = with[j]
+			}
+			if with[j+1] < hi {
+				hi = with[j+1]
+			}
+			if lo <= hi {
+				out = append(out, lo, hi)
+			}
+		}
+	}
+	return out
+}
+
+// subtractSurrogates removes the surrogate halves from the ranges, as they are not valid runes on their own.
+func subtractSurrogates(ranges []rune) []rune {
+	const surrogateMin, surrogateMax = 0xD800, 0xDFFF
+	var out []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if hi < surrogateMin || surrogateMax < lo {
+			out = append(out, lo, hi)
+			continue
+		}
+		if lo < surrogateMin {
+			out = append(out, lo, surrogateMin-1)
+		}
+		if surrogateMax < hi {
+			out = append(out, surrogateMax+1, hi)
+		}
+	}
+	return out
+}
@@ -0,0 +1,249 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// structTagName is the struct tag key which constrains the random value of a struct field.
+//
+//	type User struct {
+//		ID    string `random:"uuid"`
+//		Email string `random:"email"`
+//		Age   int    `random:"min=18,max=99"`
+//		Role  string `random:"oneof=admin|editor|viewer"`
+//		Name  string `random:"len=3..10"`
+//		Code  string `random:"regexp=^[A-Z]{3}$"`
+//		Notes string `random:"-"`
+//	}
+//
+// The regexp option consumes the rest of the tag, so the expression can contain commas,
+// thus it has to be the last option.
+const structTagName = "random"
+
+type structFieldTag struct {
+	Skip   bool
+	Email  bool
+	UUID   bool
+	OneOf  []string
+	Regexp *string
+	Min    *string
+	Max    *string
+	Len    *[2]int
+}
+
+func (tag structFieldTag) IsZero() bool {
+	return reflect.ValueOf(tag).IsZero()
+}
+
+func parseStructFieldTag(field reflect.StructField) structFieldTag {
+	var tag structFieldTag
+	raw, ok := field.Tag.Lookup(structTagName)
+	if !ok {
+		return tag
+	}
+	if raw == "-" {
+		tag.Skip = true
+		return tag
+	}
+	for rest := raw; rest != ""; {
+		if strings.HasPrefix(rest, "regexp=") {
+			expr := strings.TrimPrefix(rest, "regexp=")
+			tag.Regexp = &expr
+			break
+		}
+		var opt string
+		opt, rest, _ = strings.Cut(rest, ",")
+		name, value, _ := strings.Cut(opt, "=")
+		switch name {
+		case "email":
+			tag.Email = true
+		case "uuid":
+			tag.UUID = true
+		case "oneof":
+			tag.OneOf = strings.Split(value, "|")
+		case "min":
+			tag.Min = &value
+		case "max":
+			tag.Max = &value
+		case "len":
+			tag.Len = parseStructTagLen(field, value)
+		default:
+			panic(structTagErrorf(field, "unknown option %q", opt))
+		}
+	}
+	return tag
+}
+
+// parseStructTagLen parses the len option, which is either an exact length like "5", or a range like "3..10".
+func parseStructTagLen(field reflect.StructField, value string) *[2]int {
+	from, to, isRange := strings.Cut(value, "..")
+	if !isRange {
+		to = from
+	}
+	min, err1 := strconv.Atoi(from)
+	max, err2 := strconv.Atoi(to)
+	if err1 != nil || err2 != nil || min < 0 || max < min {
+		panic(structTagErrorf(field, "invalid len %q", value))
+	}
+	return &[2]int{min, max}
+}
+
+func structTagErrorf(field reflect.StructField, format string, args ...any) string {
+	return fmt.Sprintf("random: invalid struct tag on the %s field: %s", field.Name, fmt.Sprintf(format, args...))
+}
+
+// makeTagged makes a random value for the struct field, according to its random tag.
+func (f *Factory) makeTagged(rnd *Random, field reflect.StructField, T reflect.Type, tag structFieldTag) reflect.Value {
+	if T.Kind() == reflect.Pointer {
+		ptr := reflect.New(T.Elem())
+		ptr.Elem().Set(f.makeTagged(rnd, field, T.Elem(), tag))
+		return ptr
+	}
+	switch {
+	case tag.Email:
+		return f.taggedString(field, T, rnd.Contact().Email)
+	case tag.UUID:
+		return f.taggedString(field, T, rnd.UUID())
+	case tag.Regexp != nil:
+		str, err := rnd.stringFromRegexp(*tag.Regexp)
+		if err != nil {
+			panic(structTagErrorf(field, "%s", err.Error()))
+		}
+		return f.taggedString(field, T, str)
+	case 0 < len(tag.OneOf):
+		return parseStructTagValue(field, T, tag.OneOf[rnd.IntN(len(tag.OneOf))])
+	case tag.Min != nil || tag.Max != nil:
+		return f.taggedNumber(rnd, field, T, tag)
+	case tag.Len != nil:
+		return f.taggedLen(rnd, field, T, *tag.Len)
+	default:
+		return reflect.ValueOf(f.Make(rnd, reflect.New(T).Elem().Interface()))
+	}
+}
+
+func (f *Factory) taggedString(field reflect.StructField, T reflect.Type, str string) reflect.Value {
+	if T.Kind() != reflect.String {
+		panic(structTagErrorf(field, "string option used on a %s type", T.String()))
+	}
+	return reflect.ValueOf(str).Convert(T)
+}
+
+func (f *Factory) taggedLen(rnd *Random, field reflect.StructField, T reflect.Type, len [2]int) reflect.Value {
+	n := rnd.IntBetween(len[0], len[1])
+	switch T.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(rnd.StringNC(n, charsetAlpha)).Convert(T)
+	case reflect.Slice:
+		rv := reflect.MakeSlice(T, 0, n)
+		for i := 0; i < n; i++ {
+			rv = reflect.Append(rv, reflect.ValueOf(f.Make(rnd, reflect.New(T.Elem()).Elem().Interface())))
+		}
+		return rv
+	case reflect.Map:
+		rv := reflect.MakeMapWithSize(T, n)
+		for retries := 42; rv.Len() < n && 0 < retries; {
+			key := reflect.ValueOf(f.Make(rnd, reflect.New(T.Key()).Elem().Interface()))
+			if rv.MapIndex(key).IsValid() {
+				retries--
+				continue
+			}
+			rv.SetMapIndex(key, reflect.ValueOf(f.Make(rnd, reflect.New(T.Elem()).Elem().Interface())))
+		}
+		return rv
+	default:
+		panic(structTagErrorf(field, "len option used on a %s type", T.String()))
+	}
+}
+
+func (f *Factory) taggedNumber(rnd *Random, field reflect.StructField, T reflect.Type, tag structFieldTag) reflect.Value {
+	var (
+		min, max = numberBounds(T)
+		value    reflect.Value
+	)
+	if !min.IsValid() {
+		panic(structTagErrorf(field, "min and max options used on a %s type", T.String()))
+	}
+	if tag.Min != nil {
+		min = parseStructTagValue(field, T, *tag.Min)
+	}
+	if tag.Max != nil {
+		max = parseStructTagValue(field, T, *tag.Max)
+	}
+	switch {
+	case T.Kind() >= reflect.Int && T.Kind() <= reflect.Int64:
+		if max.Int() < min.Int() {
+			panic(structTagErrorf(field, "min is greater than max"))
+		}
+		value = reflect.ValueOf(int64(rnd.IntBetween(int(min.Int()), int(max.Int()))))
+	case T.Kind() >= reflect.Uint && T.Kind() <= reflect.Uintptr:
+		if max.Uint() < min.Uint() {
+			panic(structTagErrorf(field, "min is greater than max"))
+		}
+		span := max.Uint() - min.Uint()
+		if math.MaxInt < span {
+			span = math.MaxInt
+		}
+		value = reflect.ValueOf(min.Uint() + uint64(rnd.IntBetween(0, int(span))))
+	default: // float
+		lo, hi := min.Float(), max.Float()
+		switch {
+		case tag.Min == nil:
+			lo = hi - math.MaxInt32
+		case tag.Max == nil:
+			hi = lo + math.MaxInt32
+		}
+		if hi < lo {
+			panic(structTagErrorf(field, "min is greater than max"))
+		}
+		value = reflect.ValueOf(rnd.FloatBetween(lo, hi))
+	}
+	return value.Convert(T)
+}
+
+// numberBounds returns the smallest and the largest values of the numeric type.
+func numberBounds(T reflect.Type) (min, max reflect.Value) {
+	switch T.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := T.Bits()
+		min = reflect.ValueOf(int64(-1) << (bits - 1))
+		max = reflect.ValueOf(int64(uint64(1)<<(bits-1) - 1))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		min = reflect.ValueOf(uint64(0))
+		max = reflect.ValueOf(uint64(1)<<(T.Bits()-1)<<1 - 1)
+	case reflect.Float32, reflect.Float64:
+		min, max = reflect.ValueOf(float64(0)), reflect.ValueOf(float64(0))
+	default:
+		return reflect.Value{}, reflect.Value{}
+	}
+	return min.Convert(T), max.Convert(T)
+}
+
+// parseStructTagValue parses a tag value into the type of the field.
+func parseStructTagValue(field reflect.StructField, T reflect.Type, raw string) reflect.Value {
+	var (
+		v   any
+		err error
+	)
+	switch T.Kind() {
+	case reflect.String:
+		v = raw
+	case reflect.Bool:
+		v, err = strconv.ParseBool(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err = strconv.ParseInt(raw, 10, T.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err = strconv.ParseUint(raw, 10, T.Bits())
+	case reflect.Float32, reflect.Float64:
+		v, err = strconv.ParseFloat(raw, T.Bits())
+	default:
+		panic(structTagErrorf(field, "value options are not supported on a %s type", T.String()))
+	}
+	if err != nil {
+		panic(structTagErrorf(field, "invalid %s value %q", T.String(), raw))
+	}
+	return reflect.ValueOf(v).Convert(T)
+}
@@ -0,0 +1,36 @@
+package testcase
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.llib.dev/testcase/internal/environ"
+)
+
+// reproductionCommand returns a ready-to-paste command that reruns the test
+// with the same seed and ordering as the current execution.
+func (spec *Spec) reproductionCommand(testName string) string {
+	mod := getGlobalOrderMod()
+	if mod == undefinedOrdering {
+		mod = OrderingAsRandom
+	}
+	return fmt.Sprintf("%s=%d %s=%s go test -run %s -count=1",
+		environ.KeySeed, spec.seed,
+		environ.KeyOrdering, mod,
+		shellQuote(runPattern(testName)))
+}
+
+// runPattern makes a "go test -run" pattern that matches exactly the test with the given name.
+// Spaces are replaced with underscores the same way as the testing package rewrites the subtest names.
+func runPattern(testName string) string {
+	var parts []string
+	for _, part := range strings.Split(strings.ReplaceAll(testName, " ", "_"), "/") {
+		parts = append(parts, "^"+regexp.QuoteMeta(part)+"$")
+	}
+	return strings.Join(parts, "/")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
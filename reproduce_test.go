@@ -0,0 +1,54 @@
+package testcase
+
+import (
+	"fmt"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+)
+
+func TestSpec_reproductionCommand(t *testing.T) {
+	t.Run("the run pattern matches the test exactly", func(t *testing.T) {
+		assert.Equal(t, `^TestX$/^when_ctx$/^then$`, runPattern("TestX/when_ctx/then"))
+		assert.Equal(t, `^TestX$/^when_ctx_then$`, runPattern("TestX/when ctx then"))
+		assert.Equal(t, `^TestX$/^a\.b\(c\)\*$/^#01$`, runPattern("TestX/a.b(c)*/#01"))
+	})
+
+	t.Run("single quotes are escaped for the shell", func(t *testing.T) {
+		assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+	})
+
+	t.Run("when a test fails, the reproduction command is logged with the seed and ordering", func(t *testing.T) {
+		t.Setenv(environ.KeySeed, "42")
+		t.Setenv(environ.KeyOrdering, string(OrderingAsDefined))
+		internal.CacheFlush()
+		defer internal.CacheFlush()
+
+		dtb := &doubles.TB{StubName: "TestX"}
+		s := NewSpec(dtb)
+		s.Context("when ctx", func(s *Spec) {
+			s.Test("then", func(t *T) { t.Fail() })
+		}, Group("when ctx"))
+		s.Finish()
+		dtb.Finish()
+
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), fmt.Sprintf(
+			"%s=42 %s=defined go test -run '^TestX$/^when_ctx$/^then$' -count=1",
+			environ.KeySeed, environ.KeyOrdering))
+	})
+
+	t.Run("when a test passes, no reproduction command is logged", func(t *testing.T) {
+		dtb := &doubles.TB{StubName: "TestX"}
+		s := NewSpec(dtb)
+		s.Test("then", func(t *T) {})
+		s.Finish()
+		dtb.Finish()
+
+		assert.False(t, dtb.IsFailed)
+		assert.NotContains(t, dtb.Logs.String(), "go test -run")
+	})
+}
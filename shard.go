@@ -0,0 +1,96 @@
+package testcase
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/environ"
+)
+
+type shardSettings struct {
+	// Index is the 1-based index of the current shard.
+	Index int
+	// Total is the total number of shards.
+	Total int
+}
+
+func (s shardSettings) IsZero() bool {
+	return s == shardSettings{}
+}
+
+func getShardSettings() (shardSettings, error) {
+	raw, ok := os.LookupEnv(environ.KeyShard)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return shardSettings{}, nil
+	}
+	parts := strings.Split(strings.TrimSpace(raw), "/")
+	if len(parts) != 2 {
+		return shardSettings{}, fmt.Errorf("%s has an invalid format: %q (expected format: i/n, e.g.: 1/3)", environ.KeyShard, raw)
+	}
+	index, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return shardSettings{}, fmt.Errorf("%s has an invalid shard index: %q", environ.KeyShard, raw)
+	}
+	total, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return shardSettings{}, fmt.Errorf("%s has an invalid shard total: %q", environ.KeyShard, raw)
+	}
+	if total < 1 || index < 1 || total < index {
+		return shardSettings{}, fmt.Errorf("%s is out of range: %q (expected 1 <= i <= n)", environ.KeyShard, raw)
+	}
+	return shardSettings{Index: index, Total: total}, nil
+}
+
+var (
+	shardSettingsSetup sync.Once
+	shardSettingsCache shardSettings
+	shardSettingsErr   error
+	_                  = internal.RegisterCacheFlush(func() {
+		shardSettingsSetup = sync.Once{}
+	})
+)
+
+func getCachedShardSettings() (shardSettings, error) {
+	shardSettingsSetup.Do(func() {
+		shardSettingsCache, shardSettingsErr = getShardSettings()
+	})
+	return shardSettingsCache, shardSettingsErr
+}
+
+// isShardAllowedToRun tells if the test belongs to the current shard.
+//
+// Tests under a Group or a Sequential context are kept together in the same shard,
+// thus they are assigned to a shard based on the context path of their outermost boundary.
+func (spec *Spec) isShardAllowedToRun() bool {
+	settings, err := getCachedShardSettings()
+	if err != nil || settings.IsZero() || settings.Total == 1 {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(spec.shardKey()))
+	return int(h.Sum32()%uint32(settings.Total)) == settings.Index-1
+}
+
+func (spec *Spec) shardKey() string {
+	var (
+		specs = spec.specsFromParent()
+		path  []string
+	)
+	if specs[0].testingTB != nil {
+		path = append(path, specs[0].testingTB.Name())
+	}
+	for i, s := range specs {
+		if 0 < i {
+			path = append(path, s.description)
+		}
+		if s.group != nil || s.sequential {
+			break
+		}
+	}
+	return strings.Join(path, "\x00")
+}
@@ -0,0 +1,126 @@
+package testcase
+
+import (
+	"fmt"
+	"testing"
+
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/sandbox"
+)
+
+func runShard(tb testing.TB, shard string, spec func(s *Spec, ran func(name string))) []string {
+	tb.Helper()
+	tb.Setenv(environ.KeyShard, shard)
+	internal.CacheFlush()
+	defer internal.CacheFlush()
+	var out []string
+	stub := &doubles.TB{StubName: "TestShard"}
+	defer stub.Finish()
+	s := NewSpec(stub)
+	spec(s, func(name string) { out = append(out, name) })
+	s.Finish()
+	return out
+}
+
+func TestSpec_shard(t *testing.T) {
+	flatSpec := func(s *Spec, ran func(name string)) {
+		for i := 0; i < 42; i++ {
+			name := fmt.Sprintf("test-%d", i)
+			s.Test(name, func(t *T) { ran(name) })
+		}
+	}
+
+	t.Run("when no shard is set, then all tests run", func(t *testing.T) {
+		assert.Equal(t, 42, len(runShard(t, "", flatSpec)))
+	})
+
+	t.Run("when a single shard is set, then all tests run", func(t *testing.T) {
+		assert.Equal(t, 42, len(runShard(t, "1/1", flatSpec)))
+	})
+
+	t.Run("when the tests are split between shards, then each test runs in exactly one shard", func(t *testing.T) {
+		var (
+			total int
+			seen  = map[string]int{}
+		)
+		for i := 1; i <= 3; i++ {
+			ran := runShard(t, fmt.Sprintf("%d/3", i), flatSpec)
+			assert.True(t, len(ran) < 42, "expected that a shard only runs a subset of the tests")
+			total += len(ran)
+			for _, name := range ran {
+				seen[name]++
+			}
+		}
+		assert.Equal(t, 42, total)
+		assert.Equal(t, 42, len(seen))
+	})
+
+	t.Run("when the same shard is executed repeatedly, then the same tests run", func(t *testing.T) {
+		assert.Equal(t, runShard(t, "2/3", flatSpec), runShard(t, "2/3", flatSpec))
+	})
+
+	t.Run("when tests are under a Group, then they are kept in the same shard", func(t *testing.T) {
+		groupedSpec := func(s *Spec, ran func(name string)) {
+			for i := 0; i < 8; i++ {
+				group := fmt.Sprintf("group-%d", i)
+				s.Context(group, func(s *Spec) {
+					for j := 0; j < 5; j++ {
+						name := fmt.Sprintf("%s/test-%d", group, j)
+						s.Test(name, func(t *T) { ran(name) })
+					}
+				}, Group(group))
+			}
+		}
+		groups := map[string]int{}
+		for i := 1; i <= 4; i++ {
+			shardGroups := map[string]struct{}{}
+			for _, name := range runShard(t, fmt.Sprintf("%d/4", i), groupedSpec) {
+				var group string
+				_, _ = fmt.Sscanf(name, "%7s", &group)
+				shardGroups[group] = struct{}{}
+				groups[group]++
+			}
+			for group := range shardGroups {
+				assert.Equal(t, 5, groups[group], "expected that every test of the group ran in the same shard")
+			}
+		}
+		assert.Equal(t, 8, len(groups))
+	})
+
+	t.Run("when tests are under a Sequential context, then they are kept in the same shard", func(t *testing.T) {
+		sequentialSpec := func(s *Spec, ran func(name string)) {
+			s.Context("sequential", func(s *Spec) {
+				s.Sequential()
+				for i := 0; i < 20; i++ {
+					name := fmt.Sprintf("test-%d", i)
+					s.Test(name, func(t *T) { ran(name) })
+				}
+			})
+		}
+		var counts []int
+		for i := 1; i <= 3; i++ {
+			counts = append(counts, len(runShard(t, fmt.Sprintf("%d/3", i), sequentialSpec)))
+		}
+		assert.Contains(t, counts, 20)
+		assert.Contains(t, counts, 0)
+	})
+}
+
+func TestSpec_shard_invalidSetting(t *testing.T) {
+	for _, shard := range []string{"1", "a/3", "1/b", "0/3", "4/3", "1/0"} {
+		t.Run(shard, func(t *testing.T) {
+			t.Setenv(environ.KeyShard, shard)
+			internal.CacheFlush()
+			defer internal.CacheFlush()
+			stub := &doubles.TB{}
+			defer stub.Finish()
+			out := sandbox.Run(func() { NewSpec(stub) })
+			assert.False(t, out.OK)
+			assert.True(t, stub.IsFailed)
+			assert.Contains(t, stub.Logs.String(), environ.KeyShard)
+		})
+	}
+}
@@ -1,22 +1,30 @@
 package testcase
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"sync"
+
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/environ"
+	"go.llib.dev/testcase/internal/tagexpr"
 )
 
 type tagSettings struct {
 	Include map[string]struct{}
 	Exclude map[string]struct{}
+	// Expression is the optional tag expression that the tags of a test must satisfy.
+	Expression tagexpr.Expr
 }
 
 const (
-	envKeyTagIncludeList = `TESTCASE_TAG_INCLUDE`
-	envKeyTagExcludeList = `TESTCASE_TAG_EXCLUDE`
+	envKeyTagIncludeList = environ.KeyTagInclude
+	envKeyTagExcludeList = environ.KeyTagExclude
+	envKeyTagExpression  = environ.KeyTags
 )
 
-func getTagSettings() tagSettings {
+func getTagSettings() (tagSettings, error) {
 	var settings = tagSettings{
 		Include: map[string]struct{}{},
 		Exclude: map[string]struct{}{},
@@ -36,18 +44,60 @@ func getTagSettings() tagSettings {
 		}
 	}
 
-	return settings
+	if rawExpr, ok := os.LookupEnv(envKeyTagExpression); ok && strings.TrimSpace(rawExpr) != "" {
+		expr, err := tagexpr.Parse(rawExpr)
+		if err != nil {
+			return settings, fmt.Errorf("%s: %w", envKeyTagExpression, err)
+		}
+		settings.Expression = expr
+	}
+
+	return settings, nil
 }
 
 var (
 	tagSettingsSetup sync.Once
 	tagSettingsCache tagSettings
+	tagSettingsErr   error
+	_                = internal.RegisterCacheFlush(func() {
+		tagSettingsSetup = sync.Once{}
+	})
 )
 
-func getCachedTagSettings() tagSettings {
+func getCachedTagSettings() (tagSettings, error) {
 	tagSettingsSetup.Do(func() {
-		tagSettingsCache = getTagSettings()
+		tagSettingsCache, tagSettingsErr = getTagSettings()
 	})
 
-	return tagSettingsCache
+	return tagSettingsCache, tagSettingsErr
+}
+
+func (spec *Spec) isTagAllowedToRun() bool {
+	currentTagSet := spec.getTagSet()
+	settings, err := getCachedTagSettings()
+	if err != nil {
+		return true
+	}
+
+	for tag := range currentTagSet {
+		if _, ok := settings.Exclude[tag]; ok {
+			return false
+		}
+	}
+
+	if settings.Expression != nil && !settings.Expression.Match(currentTagSet) {
+		return false
+	}
+
+	if len(settings.Include) == 0 {
+		return true
+	}
+
+	var allowed bool
+	for tag := range currentTagSet {
+		if _, ok := settings.Include[tag]; ok {
+			allowed = true
+		}
+	}
+	return allowed
 }
@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/sandbox"
 )
 
 func TestSpec_Tag_withEnvVariable(t *testing.T) {
@@ -85,6 +88,62 @@ func TestSpec_Tag_withEnvVariable(t *testing.T) {
 	})
 }
 
+func TestSpec_Tag_withTagExpression(t *testing.T) {
+	defer resetTagEnvVariables()()
+
+	t.Run(`when a tag expression is used to select certain tests`, func(t *testing.T) {
+		defer resetTagEnvVariables()()
+		os.Setenv(envKeyTagExpression, `(db && !slow) || smoke`)
+
+		t.Run(`and the spec do not have tags`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) {}, false)
+		})
+
+		t.Run(`and the spec have tags that satisfy the expression`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) { s.Tag(`db`) }, true)
+		})
+
+		t.Run(`and the spec have tags that satisfy the expression through the alternative branch`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) { s.Tag(`db`, `slow`, `smoke`) }, true)
+		})
+
+		t.Run(`and the spec have a tag that is negated in the expression`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) { s.Tag(`db`, `slow`) }, false)
+		})
+	})
+
+	t.Run(`when a tag expression is combined with the exclude list`, func(t *testing.T) {
+		defer resetTagEnvVariables()()
+		os.Setenv(envKeyTagExpression, `db || smoke`)
+		os.Setenv(envKeyTagExcludeList, `smoke`)
+
+		t.Run(`and the spec have a tag that satisfy the expression`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) { s.Tag(`db`) }, true)
+		})
+
+		t.Run(`and the spec have a tag that satisfy the expression but it is excluded`, func(t *testing.T) {
+			assertTestRan(t, func(s *Spec) { s.Tag(`smoke`) }, false)
+		})
+	})
+}
+
+func TestSpec_Tag_withInvalidTagExpression(t *testing.T) {
+	for _, expr := range []string{`db &&`, `(db || smoke`, `db & smoke`, `db smoke`} {
+		t.Run(expr, func(t *testing.T) {
+			t.Setenv(envKeyTagExpression, expr)
+			internal.CacheFlush()
+			defer internal.CacheFlush()
+			stub := &doubles.TB{}
+			defer stub.Finish()
+			out := sandbox.Run(func() { NewSpec(stub) })
+			assert.False(t, out.OK)
+			assert.True(t, stub.IsFailed)
+			assert.Contains(t, stub.Logs.String(), envKeyTagExpression)
+			assert.Contains(t, stub.Logs.String(), "invalid tag expression")
+		})
+	}
+}
+
 func resetEnv(key string) func() {
 	ogValue, ok := os.LookupEnv(key)
 
@@ -100,14 +159,17 @@ func resetEnv(key string) func() {
 func resetTagEnvVariables() func() {
 	ilr := resetEnv(envKeyTagIncludeList)
 	elr := resetEnv(envKeyTagExcludeList)
+	exr := resetEnv(envKeyTagExpression)
 	return func() {
 		ilr()
 		elr()
+		exr()
 	}
 }
 
 func resetTagCache() {
 	tagSettingsCache = tagSettings{}
+	tagSettingsErr = nil
 	tagSettingsSetup = sync.Once{}
 }
 
@@ -0,0 +1,292 @@
+//go:build go1.21
+
+// Package tclog captures structured logs per test,
+// so the log records of a test can be asserted, and printed when the test fails.
+package tclog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/internal"
+	"go.llib.dev/testcase/internal/fmterror"
+)
+
+// Record is a captured log record.
+// The attributes of groups are flattened, and their keys are qualified with the group names, like "req.id".
+type Record struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+func (r Record) String() string {
+	var sb strings.Builder
+	sb.WriteString(r.Level.String())
+	sb.WriteString(" ")
+	sb.WriteString(r.Message)
+	for _, attr := range r.Attrs {
+		_, _ = fmt.Fprintf(&sb, " %s=%v", attr.Key, attr.Value)
+	}
+	return sb.String()
+}
+
+// Logger returns an *slog.Logger whose records are captured for the test.
+// Calling it multiple times with the same test returns loggers which share the captured records.
+// When the test fails, the captured records are printed to the test's output.
+func Logger(tb testing.TB) *slog.Logger {
+	tb.Helper()
+	return slog.New(&handler{recorder: recorderOf(tb)})
+}
+
+// Let defines a testcase.Var with a capturing logger for each test.
+func Let(s *testcase.Spec) testcase.Var[*slog.Logger] {
+	s.H().Helper()
+	return testcase.Let(s, func(t *testcase.T) *slog.Logger {
+		return Logger(t)
+	})
+}
+
+// Records returns the log records captured for the test so far.
+func Records(tb testing.TB) []Record {
+	rec, ok := lookupRecorder(tb)
+	if !ok {
+		return nil
+	}
+	return rec.Records()
+}
+
+// LogContains asserts that a record with the level and message is captured for the test,
+// which has at least the expected attributes.
+// Attribute keys of groups are expected to be qualified with the group names, like "req.id",
+// or to be passed as a slog.Group.
+func LogContains(tb testing.TB, level slog.Level, msg string, attrs ...slog.Attr) {
+	tb.Helper()
+	var exp []slog.Attr
+	for _, attr := range attrs {
+		exp = flattenAttr(exp, "", attr)
+	}
+	records := Records(tb)
+	for _, r := range records {
+		if r.Level == level && r.Message == msg && hasAttrs(r.Attrs, exp) {
+			return
+		}
+	}
+	fail(tb, fmterror.Message{
+		Name:  "LogContains",
+		Cause: "No captured log record matches the expectation.",
+		Values: []fmterror.Value{
+			{Label: "level", Value: level.String()},
+			{Label: "message", Value: msg},
+			{Label: "attributes", Value: fmterror.Formatted(Record{Attrs: exp}.attrsString())},
+			{Label: "captured records", Value: fmterror.Formatted(formatRecords(records))},
+		},
+	})
+}
+
+// NoLogsAbove asserts that no record above the level is captured for the test.
+//
+//	tclog.NoLogsAbove(tb, slog.LevelInfo) // no warnings and errors
+func NoLogsAbove(tb testing.TB, level slog.Level) {
+	tb.Helper()
+	var above []Record
+	for _, r := range Records(tb) {
+		if level < r.Level {
+			above = append(above, r)
+		}
+	}
+	if len(above) == 0 {
+		return
+	}
+	fail(tb, fmterror.Message{
+		Name:  "NoLogsAbove",
+		Cause: "Log records above the level are captured.",
+		Values: []fmterror.Value{
+			{Label: "level", Value: level.String()},
+			{Label: "records", Value: fmterror.Formatted(formatRecords(above))},
+		},
+	})
+}
+
+func fail(tb testing.TB, msg fmterror.Message) {
+	tb.Helper()
+	tb.Log(msg.String())
+	tb.FailNow()
+}
+
+func (r Record) attrsString() string {
+	var parts []string
+	for _, attr := range r.Attrs {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.Key, attr.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatRecords(records []Record) string {
+	if len(records) == 0 {
+		return "none"
+	}
+	var lines []string
+	for _, r := range records {
+		lines = append(lines, r.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func hasAttrs(got, exp []slog.Attr) bool {
+expectations:
+	for _, e := range exp {
+		for _, g := range got {
+			if g.Key == e.Key && g.Value.Equal(e.Value) {
+				continue expectations
+			}
+		}
+		return false
+	}
+	return true
+}
+
+//--------------------------------------------------------------------------------------------------------------------//
+
+var recorders = struct {
+	m  sync.Mutex
+	bs map[internal.TBKey]*recorder
+}{bs: make(map[internal.TBKey]*recorder)}
+
+// recorderKey unwraps the *testcase.T to its testing.TB,
+// so the records are shared between a *testcase.T and the testing.TB of the same test.
+func recorderKey(tb testing.TB) internal.TBKey {
+	for {
+		t, ok := tb.(*testcase.T)
+		if !ok || t.TB == nil {
+			return internal.KeyOfTB(tb)
+		}
+		tb = t.TB
+	}
+}
+
+func lookupRecorder(tb testing.TB) (*recorder, bool) {
+	recorders.m.Lock()
+	defer recorders.m.Unlock()
+	rec, ok := recorders.bs[recorderKey(tb)]
+	return rec, ok
+}
+
+func recorderOf(tb testing.TB) *recorder {
+	tb.Helper()
+	key := recorderKey(tb)
+	recorders.m.Lock()
+	defer recorders.m.Unlock()
+	if rec, ok := recorders.bs[key]; ok {
+		return rec
+	}
+	rec := &recorder{tb: tb}
+	recorders.bs[key] = rec
+	testcase.OnFail(tb, func() {
+		tb.Helper()
+		if records := rec.Records(); 0 < len(records) {
+			tb.Logf("captured log records:\n%s", formatRecords(records))
+		}
+	})
+	tb.Cleanup(func() {
+		recorders.m.Lock()
+		defer recorders.m.Unlock()
+		delete(recorders.bs, key)
+	})
+	return rec
+}
+
+type recorder struct {
+	tb      testing.TB // keeps the testing.TB's address in use while it is recorded
+	m       sync.Mutex
+	records []Record
+}
+
+func (rec *recorder) Add(r Record) {
+	rec.m.Lock()
+	defer rec.m.Unlock()
+	rec.records = append(rec.records, r)
+}
+
+func (rec *recorder) Records() []Record {
+	rec.m.Lock()
+	defer rec.m.Unlock()
+	return append([]Record(nil), rec.records...)
+}
+
+//--------------------------------------------------------------------------------------------------------------------//
+
+// handler is a slog.Handler which captures every record regardless of its level.
+type handler struct {
+	recorder *recorder
+	attrs    []slog.Attr
+	group    string
+}
+
+func (h *handler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *handler) Handle(_ context.Context, r slog.Record) error {
+	attrs := append([]slog.Attr(nil), h.attrs...)
+	r.Attrs(func(attr slog.Attr) bool {
+		attrs = flattenAttr(attrs, h.group, attr)
+		return true
+	})
+	h.recorder.Add(Record{
+		Time:    r.Time,
+		Level:   r.Level,
+		Message: r.Message,
+		Attrs:   attrs,
+	})
+	return nil
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	n := *h
+	n.attrs = append([]slog.Attr(nil), h.attrs...)
+	for _, attr := range attrs {
+		n.attrs = flattenAttr(n.attrs, h.group, attr)
+	}
+	return &n
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	n := *h
+	n.group = qualify(h.group, name)
+	return &n
+}
+
+func flattenAttr(attrs []slog.Attr, group string, attr slog.Attr) []slog.Attr {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() == slog.KindGroup {
+		for _, ga := range attr.Value.Group() {
+			attrs = flattenAttr(attrs, qualify(group, attr.Key), ga)
+		}
+		return attrs
+	}
+	if attr.Equal(slog.Attr{}) {
+		return attrs
+	}
+	return append(attrs, slog.Attr{Key: qualify(group, attr.Key), Value: attr.Value})
+}
+
+func qualify(group, key string) string {
+	if group == "" {
+		return key
+	}
+	if key == "" {
+		return group
+	}
+	return group + "." + key
+}
@@ -0,0 +1,169 @@
+//go:build go1.21
+
+package tclog_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"go.llib.dev/testcase"
+	"go.llib.dev/testcase/assert"
+	"go.llib.dev/testcase/internal/doubles"
+	"go.llib.dev/testcase/let"
+	"go.llib.dev/testcase/sandbox"
+	"go.llib.dev/testcase/tclog"
+)
+
+func ExampleLogger() {
+	var tb testing.TB
+	logger := tclog.Logger(tb)
+
+	logger.Info("user created", "id", 42)
+
+	tclog.LogContains(tb, slog.LevelInfo, "user created", slog.Int("id", 42))
+	tclog.NoLogsAbove(tb, slog.LevelInfo)
+}
+
+func TestLogger(t *testing.T) {
+	s := testcase.NewSpec(t)
+
+	logger := let.Logger(s)
+
+	s.Test("records are captured per test", func(t *testcase.T) {
+		logger.Get(t).Info("hello", "foo", "bar")
+
+		records := tclog.Records(t)
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, slog.LevelInfo, records[0].Level)
+		assert.Equal(t, "hello", records[0].Message)
+		tclog.LogContains(t, slog.LevelInfo, "hello", slog.String("foo", "bar"))
+	})
+
+	s.Test("records of other tests are not visible", func(t *testcase.T) {
+		_ = logger.Get(t)
+		assert.Empty(t, tclog.Records(t))
+	})
+
+	s.Test("loggers of the same test share the records", func(t *testcase.T) {
+		logger.Get(t).Info("a")
+		tclog.Logger(t).Info("b")
+		assert.Equal(t, 2, len(tclog.Records(t)))
+	})
+
+	s.Test("records are shared between the testcase.T and its testing.TB", func(t *testcase.T) {
+		tclog.Logger(t).Info("from testcase.T")
+		tclog.Logger(t.TB).Info("from testing.TB")
+
+		tclog.LogContains(t.TB, slog.LevelInfo, "from testcase.T")
+		tclog.LogContains(t, slog.LevelInfo, "from testing.TB")
+		assert.Equal(t, 2, len(tclog.Records(t.TB)))
+	})
+
+	s.Test("groups and attributes are flattened", func(t *testcase.T) {
+		logger.Get(t).
+			With("service", "users").
+			WithGroup("req").
+			Warn("slow", "id", 7, slog.Group("db", "table", "users"))
+
+		tclog.LogContains(t, slog.LevelWarn, "slow",
+			slog.String("service", "users"),
+			slog.Int("req.id", 7),
+			slog.String("req.db.table", "users"))
+		tclog.LogContains(t, slog.LevelWarn, "slow",
+			slog.Group("req", slog.Int("id", 7)))
+	})
+}
+
+func TestLogContains(t *testing.T) {
+	for _, tc := range []struct {
+		Desc   string
+		Level  slog.Level
+		Msg    string
+		Attrs  []slog.Attr
+		Failed bool
+	}{
+		{Desc: "match", Level: slog.LevelInfo, Msg: "hello", Attrs: []slog.Attr{slog.String("foo", "bar")}},
+		{Desc: "match without attrs", Level: slog.LevelInfo, Msg: "hello"},
+		{Desc: "level mismatch", Level: slog.LevelError, Msg: "hello", Failed: true},
+		{Desc: "message mismatch", Level: slog.LevelInfo, Msg: "bye", Failed: true},
+		{Desc: "attr value mismatch", Level: slog.LevelInfo, Msg: "hello", Attrs: []slog.Attr{slog.String("foo", "baz")}, Failed: true},
+		{Desc: "missing attr", Level: slog.LevelInfo, Msg: "hello", Attrs: []slog.Attr{slog.Int("n", 1)}, Failed: true},
+	} {
+		tc := tc
+		t.Run(tc.Desc, func(t *testing.T) {
+			dtb := &doubles.TB{}
+			tclog.Logger(dtb).Info("hello", "foo", "bar")
+			out := sandbox.Run(func() { tclog.LogContains(dtb, tc.Level, tc.Msg, tc.Attrs...) })
+			assert.Equal(t, tc.Failed, !out.OK)
+			assert.Equal(t, tc.Failed, dtb.IsFailed)
+			if tc.Failed {
+				assert.Contains(t, dtb.Logs.String(), "[LogContains]")
+				assert.Contains(t, dtb.Logs.String(), "INFO hello foo=bar")
+			}
+			dtb.Finish()
+		})
+	}
+}
+
+func TestNoLogsAbove(t *testing.T) {
+	t.Run("only records up to the level", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		l := tclog.Logger(dtb)
+		l.Debug("debug")
+		l.Info("info")
+		out := sandbox.Run(func() { tclog.NoLogsAbove(dtb, slog.LevelInfo) })
+		assert.True(t, out.OK)
+		assert.False(t, dtb.IsFailed)
+	})
+	t.Run("record above the level", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		defer dtb.Finish()
+		l := tclog.Logger(dtb)
+		l.Info("info")
+		l.Error("boom", "err", "timeout")
+		out := sandbox.Run(func() { tclog.NoLogsAbove(dtb, slog.LevelInfo) })
+		assert.False(t, out.OK)
+		assert.True(t, dtb.IsFailed)
+		assert.Contains(t, dtb.Logs.String(), "[NoLogsAbove]")
+		assert.Contains(t, dtb.Logs.String(), "ERROR boom err=timeout")
+		assert.NotContains(t, dtb.Logs.String(), "INFO info\n")
+	})
+}
+
+func TestLogger_printsRecordsOnFailure(t *testing.T) {
+	t.Run("failed test", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		tclog.Logger(dtb).Info("hello", "foo", "bar")
+		dtb.Fail()
+		dtb.Finish()
+		assert.Contains(t, dtb.Logs.String(), "captured log records:\nINFO hello foo=bar")
+	})
+	t.Run("passing test", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		tclog.Logger(dtb).Info("hello", "foo", "bar")
+		dtb.Finish()
+		assert.NotContains(t, dtb.Logs.String(), "hello")
+	})
+	t.Run("records are released after the test", func(t *testing.T) {
+		dtb := &doubles.TB{}
+		tclog.Logger(dtb).Info("hello")
+		dtb.Finish()
+		assert.Empty(t, tclog.Records(dtb))
+	})
+}
+
+func TestLogger_valueTypeTB(t *testing.T) {
+	type ValueTB struct {
+		testing.TB
+		tags []string // makes the testing.TB value not hashable
+	}
+	dtb := &doubles.TB{}
+	tb := ValueTB{TB: dtb, tags: []string{"foo"}}
+	tclog.Logger(tb).Info("hello")
+	tclog.LogContains(tb, slog.LevelInfo, "hello")
+	assert.Equal(t, 1, len(tclog.Records(tb)))
+	dtb.Finish()
+	assert.False(t, dtb.IsFailed)
+	assert.Empty(t, tclog.Records(tb))
+}